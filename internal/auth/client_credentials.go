@@ -0,0 +1,142 @@
+// Package auth implements the credential flows the provider can use to
+// authenticate HashiCups API requests in addition to the API's own
+// username/password sign-in.
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// expiryDelta is how long before its reported expiry a token is refreshed,
+// so that a token does not expire while a request is in flight.
+const expiryDelta = 30 * time.Second
+
+// ClientCredentials acquires and caches OAuth2 access tokens using the
+// client credentials grant (RFC 6749, section 4.4).
+type ClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+	Scopes       []string
+
+	// HTTPClient is used to send token requests. http.DefaultClient is used
+	// when it is nil.
+	HTTPClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// tokenResponse is the successful response of a token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Token returns a valid access token, requesting a new one from the token
+// endpoint when no token has been acquired yet or the cached token is about
+// to expire.
+func (c *ClientCredentials) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && (c.expiry.IsZero() || time.Now().Add(expiryDelta).Before(c.expiry)) {
+		return c.token, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(c.Scopes) > 0 {
+		form.Set("scope", strings.Join(c.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request failed with status: %d, body: %s", res.StatusCode, body)
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return "", fmt.Errorf("decoding token response: %w", err)
+	}
+
+	if tr.AccessToken == "" {
+		return "", fmt.Errorf("token response did not contain an access_token")
+	}
+
+	if tr.TokenType != "" && !strings.EqualFold(tr.TokenType, "bearer") {
+		return "", fmt.Errorf("unsupported token type %q", tr.TokenType)
+	}
+
+	c.token = tr.AccessToken
+	c.expiry = time.Time{}
+	if tr.ExpiresIn > 0 {
+		c.expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+
+	return c.token, nil
+}
+
+// Transport is an http.RoundTripper that authenticates every request with a
+// bearer token from Source, replacing any Authorization header already set.
+type Transport struct {
+	Source *ClientCredentials
+
+	// Base is the underlying transport. http.DefaultTransport is used when
+	// it is nil.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Source.Token(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("acquiring OAuth2 access token: %w", err)
+	}
+
+	// A RoundTripper must not modify the request it was given.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req)
+}
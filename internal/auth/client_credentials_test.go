@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientCredentialsToken(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unexpected error parsing form: %s", err)
+		}
+
+		if got := r.PostForm.Get("grant_type"); got != "client_credentials" {
+			t.Errorf("expected grant_type client_credentials, got %q", got)
+		}
+
+		if got := r.PostForm.Get("scope"); got != "cafes orders" {
+			t.Errorf("expected scope %q, got %q", "cafes orders", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, requests)
+	}))
+	defer server.Close()
+
+	source := &ClientCredentials{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		TokenURL:     server.URL,
+		Scopes:       []string{"cafes", "orders"},
+	}
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if token != "token-1" {
+			t.Errorf("expected cached token %q, got %q", "token-1", token)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 token request, got %d", requests)
+	}
+}
+
+func TestClientCredentialsTokenError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	source := &ClientCredentials{
+		ClientID:     "client",
+		ClientSecret: "wrong",
+		TokenURL:     server.URL,
+	}
+
+	if _, err := source.Token(context.Background()); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"access_token":"abc","token_type":"bearer"}`)
+	}))
+	defer tokenServer.Close()
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer abc" {
+			t.Errorf("expected bearer authorization, got %q", got)
+		}
+	}))
+	defer apiServer.Close()
+
+	client := &http.Client{
+		Transport: &Transport{
+			Source: &ClientCredentials{TokenURL: tokenServer.URL},
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, apiServer.URL, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The hashicups client sets its own Authorization header, which the
+	// transport must replace.
+	req.Header.Set("Authorization", "stale")
+
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+}
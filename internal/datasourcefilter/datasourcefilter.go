@@ -0,0 +1,81 @@
+// Package datasourcefilter provides the `filter` block that narrows the
+// results of plural data sources, as in:
+//
+//	filter {
+//	  name   = "name"
+//	  values = ["Latte", "Mocha"]
+//	}
+//
+// A data source adds Block to its schema with the names it can filter on,
+// keeps the blocks in a []Model field, and either matches each result with
+// Match or sends the filters to the API with Query. A result matches when,
+// for every filter, its value for the filter's name is one of the values.
+package datasourcefilter
+
+import (
+	"net/url"
+	"slices"
+
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model maps a filter block.
+type Model struct {
+	Name   types.String   `tfsdk:"name"`
+	Values []types.String `tfsdk:"values"`
+}
+
+// Block returns the `filter` block for a data source schema, accepting the
+// given names.
+func Block(names ...string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Narrows the results to those whose value for name is one of values. " +
+			"Results must match every filter.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(names...),
+					},
+				},
+				"values": schema.ListAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// Match reports whether a result with the given values by filter name
+// matches every filter. A result without a value for a filter's name does
+// not match it.
+func Match(filters []Model, fields map[string]string) bool {
+	for _, filter := range filters {
+		value, ok := fields[filter.Name.ValueString()]
+		if !ok || !slices.ContainsFunc(filter.Values, func(v types.String) bool { return v.ValueString() == value }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Query returns the filters as query parameters, for APIs that filter
+// their results themselves. Each value is sent as a separate parameter
+// named after the filter.
+func Query(filters []Model) url.Values {
+	query := url.Values{}
+	for _, filter := range filters {
+		for _, value := range filter.Values {
+			query.Add(filter.Name.ValueString(), value.ValueString())
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,71 @@
+package datasourcefilter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func filter(name string, values ...string) Model {
+	m := Model{Name: types.StringValue(name)}
+	for _, value := range values {
+		m.Values = append(m.Values, types.StringValue(value))
+	}
+
+	return m
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	fields := map[string]string{"name": "Latte", "id": "3"}
+
+	testCases := map[string]struct {
+		filters  []Model
+		expected bool
+	}{
+		"no-filters": {
+			expected: true,
+		},
+		"match": {
+			filters:  []Model{filter("name", "Mocha", "Latte")},
+			expected: true,
+		},
+		"mismatch": {
+			filters: []Model{filter("name", "Mocha")},
+		},
+		"all-filters": {
+			filters:  []Model{filter("name", "Latte"), filter("id", "3")},
+			expected: true,
+		},
+		"one-filter-mismatch": {
+			filters: []Model{filter("name", "Latte"), filter("id", "4")},
+		},
+		"missing-field": {
+			filters: []Model{filter("teaser", "Latte")},
+		},
+		"no-values": {
+			filters: []Model{filter("name")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Match(testCase.filters, fields); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	query := Query([]Model{filter("status", "placed", "paid"), filter("cafe_id", "1")})
+
+	if got, expected := query.Encode(), "cafe_id=1&status=placed&status=paid"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/configvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &apiKeyResource{}
+	_ resource.ResourceWithConfigure        = &apiKeyResource{}
+	_ resource.ResourceWithConfigValidators = &apiKeyResource{}
+	_ resource.ResourceWithImportState      = &apiKeyResource{}
+)
+
+// NewAPIKeyResource is a helper function to simplify the provider implementation.
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource is the resource implementation.
+type apiKeyResource struct {
+	client *apiClient
+}
+
+// apiKeyResourceModel maps the resource schema data.
+type apiKeyResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	CafeID         types.String `tfsdk:"cafe_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Secret         types.String `tfsdk:"secret"`
+	Revoked        types.Bool   `tfsdk:"revoked"`
+	LastUsed       types.String `tfsdk:"last_used"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+// apiAPIKey is the API representation of a HashiCups API key. The API only
+// returns the secret in the response to the create request.
+type apiAPIKey struct {
+	ID             int    `json:"id,omitempty"`
+	Name           string `json:"name"`
+	CafeID         int    `json:"cafe_id,omitempty"`
+	OrganizationID int    `json:"organization_id,omitempty"`
+	Secret         string `json:"secret,omitempty"`
+	Revoked        bool   `json:"revoked,omitempty"`
+	LastUsed       string `json:"last_used,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// A key is scoped to either a cafe or an organization.
+			"cafe_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// The API only returns the secret when the key is created, so
+			// it is kept from then on and is null for imported keys.
+			"secret": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// A revoked key stops working but is kept until it is
+			// destroyed. Revoking cannot be undone, so un-revoking replaces
+			// the key.
+			"revoked": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(
+						unrevokeAPIKey,
+						"The API key is replaced when revoked changes from true to false.",
+						"The API key is replaced when `revoked` changes from `true` to `false`.",
+					),
+				},
+			},
+			"last_used": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// unrevokeAPIKey requires replacing the API key when it is revoked and the
+// plan no longer revokes it.
+func unrevokeAPIKey(_ context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.StateValue.ValueBool() && !req.PlanValue.ValueBool()
+}
+
+// ConfigValidators requires the API key to be scoped to either a cafe or an
+// organization.
+func (r *apiKeyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		configvalidator.ExactlyOneOf(
+			path.MatchRoot("cafe_id"),
+			path.MatchRoot("organization_id"),
+		),
+	}
+}
+
+// Create a new resource.
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiAPIKey
+	err := r.client.do(ctx, http.MethodPost, "/api-keys", key, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			"Could not create API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	revoke := plan.Revoked.ValueBool()
+	plan.fromAPI(created)
+	plan.Secret = types.StringValue(created.Secret)
+
+	// Keys are created active, so revoking one at create time takes a
+	// second request.
+	if revoke {
+		resp.Diagnostics.Append(r.revoke(ctx, &plan)...)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var key apiAPIKey
+	err := r.client.do(ctx, http.MethodGet, "/api-keys/"+state.ID.ValueString(), nil, &key)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups API key not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups API Key",
+			"Could not read HashiCups API key ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(key)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update revokes the API key, which is the only change that doesn't replace
+// it.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Revoked.ValueBool() {
+		resp.Diagnostics.Append(r.revoke(ctx, &state)...)
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/api-keys/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups API Key Already Deleted",
+			"The API key was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups API Key",
+			"Could not delete API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing API key by its ID. The secret cannot be
+// read back from the API, so it is null for imported keys.
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// revoke revokes the API key and maps the updated key onto the model.
+func (r *apiKeyResource) revoke(ctx context.Context, m *apiKeyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var key apiAPIKey
+	err := r.client.do(ctx, http.MethodPost, "/api-keys/"+m.ID.ValueString()+"/revoke", nil, &key)
+	if err != nil {
+		diags.AddError(
+			"Error Revoking HashiCups API Key",
+			"Could not revoke HashiCups API key ID "+m.ID.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	m.fromAPI(key)
+
+	return diags
+}
+
+// toAPI builds the API request body from the resource model.
+func (m apiKeyResourceModel) toAPI() (apiAPIKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	key := apiAPIKey{
+		Name: m.Name.ValueString(),
+	}
+	if !m.CafeID.IsNull() {
+		key.CafeID = parseObjectID(path.Root("cafe_id"), m.CafeID.ValueString(), &diags)
+	}
+	if !m.OrganizationID.IsNull() {
+		key.OrganizationID = parseObjectID(path.Root("organization_id"), m.OrganizationID.ValueString(), &diags)
+	}
+
+	return key, diags
+}
+
+// fromAPI maps an API response body onto the resource model. The secret is
+// left untouched as the API only returns it on create.
+func (m *apiKeyResourceModel) fromAPI(key apiAPIKey) {
+	m.ID = types.StringValue(strconv.Itoa(key.ID))
+	m.Name = types.StringValue(key.Name)
+	m.CafeID = types.StringNull()
+	if key.CafeID != 0 {
+		m.CafeID = types.StringValue(strconv.Itoa(key.CafeID))
+	}
+	m.OrganizationID = types.StringNull()
+	if key.OrganizationID != 0 {
+		m.OrganizationID = types.StringValue(strconv.Itoa(key.OrganizationID))
+	}
+	m.Revoked = types.BoolValue(key.Revoked)
+	m.LastUsed = types.StringNull()
+	if key.LastUsed != "" {
+		m.LastUsed = types.StringValue(key.LastUsed)
+	}
+	m.CreatedAt = types.StringValue(key.CreatedAt)
+}
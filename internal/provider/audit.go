@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// apiAudit is the audit metadata the HashiCups API returns with its objects.
+// It is embedded in the API representation of resources that expose it.
+type apiAudit struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+}
+
+// withAuditAttributes adds the read-only created_at, updated_at and owner
+// attributes to a resource schema's attributes. created_at and owner never
+// change after creation, so they keep their prior state in plans.
+func withAuditAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"created_at": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Computed: true,
+		},
+		"owner": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	})
+
+	return attributes
+}
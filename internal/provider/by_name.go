@@ -0,0 +1,25 @@
+package provider
+
+import "slices"
+
+// indexByName returns items keyed by the name the name function returns for
+// them, for the *_by_name attributes of list data sources. When several items
+// share a name the first one is kept, and the shared names are returned in
+// the order they were first repeated so that they can be reported.
+func indexByName[T any](items []T, name func(T) string) (map[string]T, []string) {
+	index := make(map[string]T, len(items))
+	var duplicates []string
+	for _, item := range items {
+		key := name(item)
+		if _, ok := index[key]; ok {
+			if !slices.Contains(duplicates, key) {
+				duplicates = append(duplicates, key)
+			}
+			continue
+		}
+
+		index[key] = item
+	}
+
+	return index, duplicates
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIndexByName(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		ID   int
+		Name string
+	}
+
+	testCases := map[string]struct {
+		items              []item
+		expected           map[string]int
+		expectedDuplicates []string
+	}{
+		"empty": {
+			expected: map[string]int{},
+		},
+		"unique": {
+			items:    []item{{ID: 1, Name: "latte"}, {ID: 2, Name: "mocha"}},
+			expected: map[string]int{"latte": 1, "mocha": 2},
+		},
+		"duplicates-keep-first": {
+			items: []item{
+				{ID: 1, Name: "latte"},
+				{ID: 2, Name: "mocha"},
+				{ID: 3, Name: "latte"},
+				{ID: 4, Name: "latte"},
+				{ID: 5, Name: "mocha"},
+			},
+			expected:           map[string]int{"latte": 1, "mocha": 2},
+			expectedDuplicates: []string{"latte", "mocha"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			index, duplicates := indexByName(testCase.items, func(i item) string {
+				return i.Name
+			})
+
+			ids := make(map[string]int, len(index))
+			for key, i := range index {
+				ids[key] = i.ID
+			}
+
+			if len(ids) != len(testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, ids)
+			}
+			for key, id := range testCase.expected {
+				if ids[key] != id {
+					t.Errorf("expected %v, got %v", testCase.expected, ids)
+				}
+			}
+			if !slices.Equal(duplicates, testCase.expectedDuplicates) {
+				t.Errorf("expected duplicates %v, got %v", testCase.expectedDuplicates, duplicates)
+			}
+		})
+	}
+}
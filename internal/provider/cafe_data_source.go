@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/configvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &cafeDataSource{}
+	_ datasource.DataSourceWithConfigure        = &cafeDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &cafeDataSource{}
+)
+
+// NewCafeDataSource is a helper function to simplify the provider implementation.
+func NewCafeDataSource() datasource.DataSource {
+	return &cafeDataSource{}
+}
+
+// cafeDataSource is the data source implementation.
+type cafeDataSource struct {
+	client *apiClient
+}
+
+// cafeDataSourceModel maps the data source schema data.
+type cafeDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Address     types.String `tfsdk:"address"`
+	Description types.String `tfsdk:"description"`
+	Image       types.String `tfsdk:"image"`
+}
+
+// Metadata returns the data source type name.
+func (d *cafeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cafe"
+}
+
+// Schema defines the schema for the data source.
+func (d *cafeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"address": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"image": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires the cafe to be looked up by either its ID or its
+// name.
+func (d *cafeDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		configvalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read looks the cafe up by ID or name and refreshes the Terraform state.
+func (d *cafeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cafeDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cafe hashicups.Cafe
+	if !config.ID.IsNull() {
+		cafes, err := d.client.GetCafe(config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read HashiCups Cafe",
+				err.Error(),
+			)
+			return
+		}
+
+		if len(cafes) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Cafe Not Found",
+				fmt.Sprintf("No cafe found with the ID %q.", config.ID.ValueString()),
+			)
+			return
+		}
+
+		cafe = cafes[0]
+	} else {
+		cafes, err := findCafesByName(ctx, d.client, config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read HashiCups Cafes",
+				err.Error(),
+			)
+			return
+		}
+
+		switch len(cafes) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Cafe Not Found",
+				fmt.Sprintf("No cafe found with the name %q.", config.Name.ValueString()),
+			)
+			return
+		case 1:
+			cafe = cafes[0]
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple Cafes Found",
+				fmt.Sprintf("Found %d cafes with the name %q. Look the cafe up by id instead.", len(cafes), config.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	// Map response body to model
+	state := cafeDataSourceModel{
+		ID:          types.StringValue(strconv.Itoa(cafe.ID)),
+		Name:        types.StringValue(cafe.Name),
+		Address:     types.StringValue(cafe.Address),
+		Description: types.StringValue(cafe.Description),
+		Image:       types.StringValue(cafe.Image),
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *cafeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
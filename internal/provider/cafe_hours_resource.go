@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &cafeHoursResource{}
+	_ resource.ResourceWithConfigure      = &cafeHoursResource{}
+	_ resource.ResourceWithValidateConfig = &cafeHoursResource{}
+	_ resource.ResourceWithImportState    = &cafeHoursResource{}
+)
+
+// NewCafeHoursResource is a helper function to simplify the provider implementation.
+func NewCafeHoursResource() resource.Resource {
+	return &cafeHoursResource{}
+}
+
+// cafeHoursResource is the resource implementation.
+type cafeHoursResource struct {
+	client *apiClient
+}
+
+// cafeHoursResourceModel maps the resource schema data.
+type cafeHoursResourceModel struct {
+	ID         types.String              `tfsdk:"id"`
+	CafeID     types.String              `tfsdk:"cafe_id"`
+	Weekly     []openingHoursModel       `tfsdk:"weekly"`
+	Exceptions []cafeHoursExceptionModel `tfsdk:"exceptions"`
+}
+
+// cafeHoursExceptionModel maps the hours of a cafe on a holiday or another
+// day that differs from its weekly hours.
+type cafeHoursExceptionModel struct {
+	Date   types.String `tfsdk:"date"`
+	Closed types.Bool   `tfsdk:"closed"`
+	Opens  types.String `tfsdk:"opens"`
+	Closes types.String `tfsdk:"closes"`
+}
+
+// apiCafeHours is the API representation of a cafe's weekly hours and the
+// exceptions to them.
+type apiCafeHours struct {
+	Weekly     []apiOpeningHours       `json:"weekly"`
+	Exceptions []apiCafeHoursException `json:"exceptions"`
+}
+
+// apiCafeHoursException is the API representation of the hours of a cafe on
+// a single date. Opens and Closes are empty when the cafe is closed.
+type apiCafeHoursException struct {
+	Date   string `json:"date"`
+	Closed bool   `json:"closed"`
+	Opens  string `json:"opens,omitempty"`
+	Closes string `json:"closes,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *cafeHoursResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cafe_hours"
+}
+
+// Schema defines the schema for the resource.
+func (r *cafeHoursResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the weekly hours of a cafe and the exceptions to them. " +
+			"The hours of a cafe must be managed either by this resource or by the opening_hours attribute of its hashicups_cafe resource, not both. " +
+			"A cafe that sets opening_hours while this resource manages its hours fails to plan.",
+		MarkdownDescription: "Manages the weekly hours of a cafe and the exceptions to them. " +
+			"The hours of a cafe must be managed either by this resource or by the `opening_hours` attribute of its `hashicups_cafe` resource, not both. " +
+			"A cafe that sets `opening_hours` while this resource manages its hours fails to plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"weekly": schema.SetNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(weekdays...),
+							},
+						},
+						"opens": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.TimeOfDay(),
+							},
+						},
+						"closes": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.TimeOfDay(),
+							},
+						},
+					},
+				},
+			},
+			// An exception either closes the cafe for the day or sets
+			// different opens and closes times.
+			"exceptions": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.Date(),
+							},
+						},
+						"closed": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"opens": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.TimeOfDay(),
+							},
+						},
+						"closes": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.TimeOfDay(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that each exception either closes the cafe or sets
+// both its opens and closes times.
+func (r *cafeHoursResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var exceptionsValue types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("exceptions"), &exceptionsValue)...)
+	if resp.Diagnostics.HasError() || exceptionsValue.IsNull() || exceptionsValue.IsUnknown() {
+		return
+	}
+
+	var exceptions []cafeHoursExceptionModel
+	resp.Diagnostics.Append(exceptionsValue.ElementsAs(ctx, &exceptions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, exception := range exceptions {
+		if exception.Closed.IsUnknown() || exception.Opens.IsUnknown() || exception.Closes.IsUnknown() {
+			continue
+		}
+
+		hasHours := !exception.Opens.IsNull() || !exception.Closes.IsNull()
+		switch {
+		case exception.Closed.ValueBool() && hasHours:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("exceptions"),
+				"Invalid Cafe Hours Exception",
+				"The exception on "+exception.Date.ValueString()+" closes the cafe, so opens and closes must not be set.",
+			)
+		case !exception.Closed.ValueBool() && (exception.Opens.IsNull() || exception.Closes.IsNull()):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("exceptions"),
+				"Invalid Cafe Hours Exception",
+				"The exception on "+exception.Date.ValueString()+" must set both opens and closes, or set closed to true.",
+			)
+		}
+	}
+}
+
+// Create a new resource.
+func (r *cafeHoursResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cafeHoursResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Creating the resource replaces whatever hours the cafe currently has.
+	var hours apiCafeHours
+	err := r.client.do(ctx, http.MethodPut, "/cafes/"+plan.CafeID.ValueString()+"/hours", plan.toAPI(), &hours)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cafe hours",
+			"Could not create cafe hours, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.CafeID
+	plan.fromAPI(hours)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *cafeHoursResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cafeHoursResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hours apiCafeHours
+	err := r.client.do(ctx, http.MethodGet, "/cafes/"+state.ID.ValueString()+"/hours", nil, &hours)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups cafe hours not found, removing from state", map[string]any{"cafe_id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Cafe Hours",
+			"Could not read hours of HashiCups cafe ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.CafeID = state.ID
+	state.fromAPI(hours)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cafeHoursResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cafeHoursResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hours apiCafeHours
+	err := r.client.do(ctx, http.MethodPut, "/cafes/"+plan.ID.ValueString()+"/hours", plan.toAPI(), &hours)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Cafe Hours",
+			"Could not update cafe hours, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(hours)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cafeHoursResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cafeHoursResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/cafes/"+state.ID.ValueString()+"/hours", nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Cafe Hours Already Deleted",
+			"The cafe hours were not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Cafe Hours",
+			"Could not delete cafe hours, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cafeHoursResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the hours of an existing cafe by the cafe ID.
+func (r *cafeHoursResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m cafeHoursResourceModel) toAPI() apiCafeHours {
+	hours := apiCafeHours{
+		Weekly:     []apiOpeningHours{},
+		Exceptions: []apiCafeHoursException{},
+	}
+	for _, weekly := range m.Weekly {
+		hours.Weekly = append(hours.Weekly, apiOpeningHours{
+			Day:    weekly.Day.ValueString(),
+			Opens:  weekly.Opens.ValueString(),
+			Closes: weekly.Closes.ValueString(),
+		})
+	}
+	for _, exception := range m.Exceptions {
+		hours.Exceptions = append(hours.Exceptions, apiCafeHoursException{
+			Date:   exception.Date.ValueString(),
+			Closed: exception.Closed.ValueBool(),
+			Opens:  exception.Opens.ValueString(),
+			Closes: exception.Closes.ValueString(),
+		})
+	}
+
+	return hours
+}
+
+// fromAPI maps an API response body onto the resource model. The API does
+// not distinguish between no exceptions and an empty set of exceptions, so
+// exceptions stay null when they are not configured and there are none.
+func (m *cafeHoursResourceModel) fromAPI(hours apiCafeHours) {
+	m.Weekly = []openingHoursModel{}
+	for _, weekly := range hours.Weekly {
+		m.Weekly = append(m.Weekly, openingHoursModel{
+			Day:    types.StringValue(weekly.Day),
+			Opens:  types.StringValue(weekly.Opens),
+			Closes: types.StringValue(weekly.Closes),
+		})
+	}
+
+	if m.Exceptions == nil && len(hours.Exceptions) == 0 {
+		return
+	}
+
+	m.Exceptions = []cafeHoursExceptionModel{}
+	for _, exception := range hours.Exceptions {
+		m.Exceptions = append(m.Exceptions, cafeHoursExceptionModel{
+			Date:   types.StringValue(exception.Date),
+			Closed: types.BoolValue(exception.Closed),
+			Opens:  stringValueOrNull(exception.Opens),
+			Closes: stringValueOrNull(exception.Closes),
+		})
+	}
+}
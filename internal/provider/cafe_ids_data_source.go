@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/datasourcefilter"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cafeIDsDataSource{}
+	_ datasource.DataSourceWithConfigure = &cafeIDsDataSource{}
+)
+
+// NewCafeIDsDataSource is a helper function to simplify the provider implementation.
+func NewCafeIDsDataSource() datasource.DataSource {
+	return &cafeIDsDataSource{}
+}
+
+// cafeIDsDataSource is the data source implementation.
+type cafeIDsDataSource struct {
+	client *apiClient
+}
+
+// cafeIDsDataSourceModel maps the data source schema data.
+type cafeIDsDataSourceModel struct {
+	PageSize types.Int64              `tfsdk:"page_size"`
+	Filters  []datasourcefilter.Model `tfsdk:"filter"`
+	IDs      types.Set                `tfsdk:"ids"`
+}
+
+// apiCafeKey is the API representation of the fields of a cafe that the
+// cafe IDs can be filtered by, which are the only fields requested.
+type apiCafeKey struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// Metadata returns the data source type name.
+func (d *cafeIDsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cafe_ids"
+}
+
+// Schema defines the schema for the data source.
+func (d *cafeIDsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"page_size": pageSizeAttribute(),
+			// ids is a set so that it can be used with for_each directly.
+			"ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": datasourcefilter.Block("id", "name", "address"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the IDs of the cafes matching the
+// filters. Only the fields the filters can match are requested from the API.
+func (d *cafeIDsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state cafeIDsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{"fields": {"id,name,address"}}
+
+	cafes, err := listAll(ctx, d.client, "/cafes", query, newListOptions(types.Int64Null(), state.PageSize), func(cafe apiCafeKey) bool {
+		return datasourcefilter.Match(state.Filters, map[string]string{
+			"id":      strconv.Itoa(cafe.ID),
+			"name":    cafe.Name,
+			"address": cafe.Address,
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Cafes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	ids := make([]string, 0, len(cafes))
+	for _, cafe := range cafes {
+		ids = append(ids, strconv.Itoa(cafe.ID))
+	}
+
+	state.IDs, diags = types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *cafeIDsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
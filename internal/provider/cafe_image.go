@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// imageHash returns the hex-encoded SHA-256 checksum of an image.
+func imageHash(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// hasUploadedImage reports whether the cafe's image is uploaded from
+// image_file or image_content_base64 rather than referenced by its URL.
+// image_content_base64 is write-only and so always null in plan and state,
+// but image_hash is planned whenever either is configured.
+func (m cafeResourceModel) hasUploadedImage() bool {
+	return !m.ImageFile.IsNull() || !m.ImageHash.IsNull()
+}
+
+// readImage returns the image configured by image_file or, when that is null,
+// by image_content_base64. It returns nil when neither is configured.
+func readImage(imageFile, imageContentBase64 types.String) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !imageFile.IsNull():
+		content, err := os.ReadFile(imageFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("image_file"),
+				"Unable to Read Image File",
+				"Could not read the cafe image: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		return content, diags
+	case !imageContentBase64.IsNull():
+		content, err := base64.StdEncoding.DecodeString(imageContentBase64.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("image_content_base64"),
+				"Invalid Image Content",
+				"Could not decode the base64-encoded cafe image: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		return content, diags
+	}
+
+	return nil, diags
+}
+
+// planImageHash sets image_hash to the checksum of the image configured by
+// image_file or image_content_base64, so that changing the image plans an
+// update of the cafe. image_hash is null when neither is configured, and left
+// unknown while either is. image_content_base64 is write-only, so it is read
+// from the configuration.
+func planImageHash(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var imageFile, imageContentBase64 types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("image_file"), &imageFile)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("image_content_base64"), &imageContentBase64)...)
+	if resp.Diagnostics.HasError() || imageFile.IsUnknown() || imageContentBase64.IsUnknown() {
+		return
+	}
+
+	content, diags := readImage(imageFile, imageContentBase64)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash := types.StringNull()
+	if content != nil {
+		hash = types.StringValue(imageHash(content))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("image_hash"), hash)...)
+}
+
+// uploadImage uploads the image configured by the model's image_file or by
+// image_content_base64 in config as the image of the cafe, and returns the
+// updated cafe and its ETag. It fails when the image no longer matches the
+// planned image_hash, as the file changed since the plan was made.
+func (r *cafeResource) uploadImage(ctx context.Context, config tfsdk.Config, m cafeResourceModel, cafeID, ifMatch string) (*apiCafe, string, diag.Diagnostics) {
+	var imageContentBase64 types.String
+	diags := config.GetAttribute(ctx, path.Root("image_content_base64"), &imageContentBase64)
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	content, d := readImage(m.ImageFile, imageContentBase64)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	if imageHash(content) != m.ImageHash.ValueString() {
+		diags.AddAttributeError(
+			path.Root("image_file"),
+			"Image File Changed After Plan",
+			"The content of the image file changed since the plan was made. Plan and apply again to upload the current file.",
+		)
+		return nil, "", diags
+	}
+
+	cafe, etag, err := r.client.uploadCafeImage(ctx, cafeID, content, ifMatch)
+	if err != nil {
+		diags.AddError(
+			"Error Uploading HashiCups Cafe Image",
+			"Could not upload image for HashiCups cafe ID "+cafeID+": "+err.Error(),
+		)
+		return nil, "", diags
+	}
+
+	return cafe, etag, diags
+}
+
+// uploadCafeImage replaces the image of the cafe with the given ID, and
+// returns the updated cafe and its ETag.
+func (c *apiClient) uploadCafeImage(ctx context.Context, cafeID string, content []byte, ifMatch string) (*apiCafe, string, error) {
+	var updated apiCafe
+	header, err := c.sendBody(ctx, http.MethodPut, "/cafes/"+cafeID+"/image", ifMatchHeader(ifMatch), http.DetectContentType(content), content, &updated)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &updated, header.Get("ETag"), nil
+}
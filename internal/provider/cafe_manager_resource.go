@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &cafeManagerResource{}
+	_ resource.ResourceWithConfigure   = &cafeManagerResource{}
+	_ resource.ResourceWithImportState = &cafeManagerResource{}
+)
+
+// cafeManagerChildType is the child type of the composite IDs of cafe
+// managers, which are of the form "cafe/<cafe_id>/manager/<user_id>".
+const cafeManagerChildType = "manager"
+
+// NewCafeManagerResource is a helper function to simplify the provider implementation.
+func NewCafeManagerResource() resource.Resource {
+	return &cafeManagerResource{}
+}
+
+// cafeManagerResource is the resource implementation. Each resource grants
+// the manager role on a cafe to a single user, so every grant shows up in
+// plans on its own.
+type cafeManagerResource struct {
+	client *apiClient
+}
+
+// cafeManagerResourceModel maps the resource schema data.
+type cafeManagerResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	CafeID types.String `tfsdk:"cafe_id"`
+	UserID types.String `tfsdk:"user_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *cafeManagerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cafe_manager"
+}
+
+// Schema defines the schema for the resource.
+func (r *cafeManagerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *cafeManagerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cafeManagerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Granting the role to a user who already manages the cafe succeeds.
+	err := r.client.do(ctx, http.MethodPut, plan.apiPath(), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cafe manager",
+			"Could not create cafe manager, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(cafeCompositeID{
+		CafeID:    plan.CafeID.ValueString(),
+		ChildType: cafeManagerChildType,
+		ChildID:   plan.UserID.ValueString(),
+	}.String())
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *cafeManagerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cafeManagerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodGet, state.apiPath(), nil, nil)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups cafe manager not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Cafe Manager",
+			"Could not read HashiCups cafe manager ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called with changes, as every attribute requires the
+// resource to be replaced.
+func (r *cafeManagerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cafeManagerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete revokes the manager role. The user and cafe themselves are kept.
+func (r *cafeManagerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cafeManagerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, state.apiPath(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Cafe Manager Already Deleted",
+			"The cafe manager was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Cafe Manager",
+			"Could not delete cafe manager, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cafeManagerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports a manager of a cafe by its composite ID,
+// "cafe/<cafe_id>/manager/<user_id>".
+func (r *cafeManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := parseCafeID(req.ID)
+	if err == nil && id.ChildType != cafeManagerChildType {
+		err = fmt.Errorf("expected an ID of the form cafe/<cafe_id>/%s/<user_id>, got: %q", cafeManagerChildType, req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Cafe Manager Import ID",
+			"Could not parse the import ID: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cafe_id"), id.CafeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), id.ChildID)...)
+}
+
+// apiPath returns the API path of the manager of the cafe.
+func (m cafeManagerResourceModel) apiPath() string {
+	return "/cafes/" + m.CafeID.ValueString() + "/managers/" + m.UserID.ValueString()
+}
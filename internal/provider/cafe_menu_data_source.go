@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cafeMenuDataSource{}
+	_ datasource.DataSourceWithConfigure = &cafeMenuDataSource{}
+)
+
+// NewCafeMenuDataSource is a helper function to simplify the provider implementation.
+func NewCafeMenuDataSource() datasource.DataSource {
+	return &cafeMenuDataSource{}
+}
+
+// cafeMenuDataSource is the data source implementation.
+type cafeMenuDataSource struct {
+	client *apiClient
+}
+
+// cafeMenuDataSourceModel maps the data source schema data.
+type cafeMenuDataSourceModel struct {
+	CafeID  types.String          `tfsdk:"cafe_id"`
+	Coffees []cafeMenuCoffeeModel `tfsdk:"coffees"`
+}
+
+// cafeMenuCoffeeModel maps a coffee on the menu with its effective price.
+type cafeMenuCoffeeModel struct {
+	ID          types.Int64   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	Price       decimal.Value `tfsdk:"price"`
+	ListPrice   decimal.Value `tfsdk:"list_price"`
+}
+
+// Metadata returns the data source type name.
+func (d *cafeMenuDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cafe_menu"
+}
+
+// Schema defines the schema for the data source.
+func (d *cafeMenuDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+			},
+			"coffees": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						// price is what the coffee costs at the cafe: the
+						// cafe's override when it has one, and otherwise
+						// list_price.
+						"price": schema.StringAttribute{
+							CustomType: decimal.Type{},
+							Computed:   true,
+						},
+						"list_price": schema.StringAttribute{
+							CustomType: decimal.Type{},
+							Computed:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read resolves the coffees on the menu of the cafe against the coffee
+// catalog and refreshes the Terraform state.
+func (d *cafeMenuDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state cafeMenuDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	menu, _, err := d.client.getMenu(ctx, state.CafeID.ValueString())
+	if isNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cafe_id"),
+			"Cafe Not Found",
+			fmt.Sprintf("No cafe found with the ID %q.", state.CafeID.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Cafe Menu",
+			err.Error(),
+		)
+		return
+	}
+
+	coffees, err := d.client.GetCoffees()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Coffees",
+			err.Error(),
+		)
+		return
+	}
+
+	catalog := make(map[int]hashicups.Coffee, len(coffees))
+	for _, coffee := range coffees {
+		catalog[coffee.ID] = coffee
+	}
+
+	// Map response body to model
+	state.Coffees = []cafeMenuCoffeeModel{}
+	for _, item := range menu.Items {
+		coffee, ok := catalog[item.CoffeeID]
+		if !ok {
+			resp.Diagnostics.AddWarning(
+				"Unknown Coffee on Cafe Menu",
+				fmt.Sprintf("The menu of cafe ID %s lists coffee ID %d, which is not in the coffee catalog. It has been left out.", state.CafeID.ValueString(), item.CoffeeID),
+			)
+			continue
+		}
+
+		listPrice := decimal.NewFloat64Value(coffee.Price)
+		price := listPrice
+		if item.Price != nil {
+			price = decimal.NewValue(item.Price.String())
+		}
+
+		state.Coffees = append(state.Coffees, cafeMenuCoffeeModel{
+			ID:          types.Int64Value(int64(coffee.ID)),
+			Name:        types.StringValue(coffee.Name),
+			Description: types.StringValue(coffee.Description),
+			Price:       price,
+			ListPrice:   listPrice,
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *cafeMenuDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
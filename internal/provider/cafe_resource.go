@@ -1,21 +1,74 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"terraform-provider-inpyu-ossca/internal/timeouts"
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/types/jsontypes"
+	"terraform-provider-inpyu-ossca/internal/types/normalizedstring"
+	"terraform-provider-inpyu-ossca/internal/types/urltype"
+	"terraform-provider-inpyu-ossca/internal/validators/configvalidator"
+	"terraform-provider-inpyu-ossca/internal/validators/float64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/inpyu/hashicups-client-go"
 )
 
 var (
-	_ resource.Resource              = &cafeResource{}
-	_ resource.ResourceWithConfigure = &cafeResource{}
+	_ resource.Resource                     = &cafeResource{}
+	_ resource.ResourceWithConfigure        = &cafeResource{}
+	_ resource.ResourceWithImportState      = &cafeResource{}
+	_ resource.ResourceWithModifyPlan       = &cafeResource{}
+	_ resource.ResourceWithUpgradeState     = &cafeResource{}
+	_ resource.ResourceWithMoveState        = &cafeResource{}
+	_ resource.ResourceWithValidateConfig   = &cafeResource{}
+	_ resource.ResourceWithConfigValidators = &cafeResource{}
+)
+
+// legacyCafeTypeName is the type name of the cafe resource in the legacy
+// SDKv2-based HashiCups provider, which state can be moved from.
+const legacyCafeTypeName = "hashicups_cafe"
+
+// cafeImportNamePrefix marks an import ID as a cafe name rather than a
+// numeric cafe ID, e.g. `terraform import inpyu_cafe.example name=Sample Cafe`.
+const cafeImportNamePrefix = "name="
+
+// defaultCafeTimeout bounds cafe create, update and delete operations when
+// the timeouts block does not configure them.
+const defaultCafeTimeout = 10 * time.Minute
+
+// cafeStatuses are the lifecycle states a cafe can be in.
+var cafeStatuses = []string{"open", "closed", "under_renovation"}
+
+// weekdays are the valid values of an opening_hours day.
+var weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// Length limits enforced by the HashiCups API for cafe attributes.
+const (
+	cafeNameMaxLength        = 255
+	cafeDescriptionMaxLength = 1000
 )
 
 func NewCafeResource() resource.Resource {
@@ -23,42 +76,334 @@ func NewCafeResource() resource.Resource {
 }
 
 type cafeResource struct {
-	client *hashicups.Client
+	client *apiClient
 }
 
 type cafeResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Address     types.String `tfsdk:"address"`
-	Description types.String `tfsdk:"description"`
-	Image       types.String `tfsdk:"image"`
+	ID          types.String           `tfsdk:"id"`
+	Name        normalizedstring.Value `tfsdk:"name"`
+	Slug        types.String           `tfsdk:"slug"`
+	Address     normalizedstring.Value `tfsdk:"address"`
+	Description types.String           `tfsdk:"description"`
+	Image       urltype.Value          `tfsdk:"image"`
+	ImageFile   types.String           `tfsdk:"image_file"`
+	ImageHash   types.String           `tfsdk:"image_hash"`
+
+	ImageContentBase64 types.String         `tfsdk:"image_content_base64"`
+	Status             types.String         `tfsdk:"status"`
+	Tags               types.Map            `tfsdk:"tags"`
+	TagsAll            types.Map            `tfsdk:"tags_all"`
+	OpeningHours       []openingHoursModel  `tfsdk:"opening_hours"`
+	Location           *locationModel       `tfsdk:"location"`
+	Metadata           jsontypes.Normalized `tfsdk:"metadata"`
+	TaxRateID          types.String         `tfsdk:"tax_rate_id"`
+	OrgID              types.String         `tfsdk:"org_id"`
+	PriceListID        types.String         `tfsdk:"price_list_id"`
+	MenuItems          types.List           `tfsdk:"menu_items"`
+	LastUpdated        types.String         `tfsdk:"last_updated"`
+	CreatedAt          types.String         `tfsdk:"created_at"`
+	UpdatedAt          types.String         `tfsdk:"updated_at"`
+	Owner              types.String         `tfsdk:"owner"`
+
+	AdminPassword        types.String `tfsdk:"admin_password"`
+	AdminPasswordVersion types.String `tfsdk:"admin_password_version"`
+
+	DeletionProtection     types.Bool   `tfsdk:"deletion_protection"`
+	ForceDestroy           types.Bool   `tfsdk:"force_destroy"`
+	AdoptExisting          types.Bool   `tfsdk:"adopt_existing"`
+	ReplaceOnAddressChange types.Bool   `tfsdk:"replace_on_address_change"`
+	RequireUniqueName      types.Bool   `tfsdk:"require_unique_name"`
+	Timeouts               types.Object `tfsdk:"timeouts"`
+}
+
+// locationModel maps the geographic coordinates of a cafe.
+type locationModel struct {
+	Latitude  types.Float64 `tfsdk:"latitude"`
+	Longitude types.Float64 `tfsdk:"longitude"`
+}
+
+// openingHoursModel maps the opening hours of a cafe on one day of the week.
+type openingHoursModel struct {
+	Day    types.String `tfsdk:"day"`
+	Opens  types.String `tfsdk:"opens"`
+	Closes types.String `tfsdk:"closes"`
+}
+
+// cafeMenuItemModel maps a coffee on the cafe's current menu.
+type cafeMenuItemModel struct {
+	CoffeeID types.Int64   `tfsdk:"coffee_id"`
+	Name     types.String  `tfsdk:"name"`
+	Price    decimal.Value `tfsdk:"price"`
+}
+
+// cafeMenuItemAttrTypes are the attribute types of a menu_items element.
+var cafeMenuItemAttrTypes = map[string]attr.Type{
+	"coffee_id": types.Int64Type,
+	"name":      types.StringType,
+	"price":     decimal.Type{},
+}
+
+// apiCafe is the API representation of a cafe. It extends hashicups.Cafe with
+// the fields the hashicups-client-go library does not know about yet.
+type apiCafe struct {
+	ID           int                `json:"id,omitempty"`
+	Name         string             `json:"name"`
+	Address      string             `json:"address"`
+	Description  string             `json:"description"`
+	Image        *string            `json:"image,omitempty"`
+	Status       string             `json:"status,omitempty"`
+	Labels       map[string]string  `json:"labels"`
+	OpeningHours *[]apiOpeningHours `json:"opening_hours,omitempty"`
+	Location     *apiLocation       `json:"location"`
+	Metadata     json.RawMessage    `json:"metadata"`
+	TaxRateID    *int               `json:"tax_rate_id"`
+	OrgID        *int               `json:"org_id"`
+	PriceListID  *int               `json:"price_list_id"`
+	apiAudit
+
+	// AdminPassword is accepted by the API on create and update but never
+	// returned.
+	AdminPassword string `json:"admin_password,omitempty"`
+}
+
+// apiLocation is the API representation of a cafe's geographic coordinates,
+// in decimal degrees.
+type apiLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// apiOpeningHours is the API representation of a cafe's opening hours on one
+// day. Opens and Closes are times of day in HH:MM format.
+type apiOpeningHours struct {
+	Day    string `json:"day"`
+	Opens  string `json:"opens"`
+	Closes string `json:"closes"`
 }
 
 func (r *cafeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cafe"
 }
 
-func (r *cafeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *cafeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
+		// Bump the version and add a state upgrader to UpgradeState whenever
+		// an attribute is renamed or changes shape.
+		Version: 1,
+		Attributes: withAuditAttributes(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			// The API trims and may re-case the name and address, so they use a
+			// type that treats such differences as semantically equal.
 			"name": schema.StringAttribute{
-				Optional: true,
+				CustomType: normalizedstring.Type{},
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, cafeNameMaxLength),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					slugFromName(),
+				},
 			},
 			"address": schema.StringAttribute{
-				Optional: true,
+				CustomType: normalizedstring.Type{},
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						replaceOnAddressChange,
+						"The cafe is replaced when its address changes and replace_on_address_change is true.",
+						"The cafe is replaced when its address changes and `replace_on_address_change` is true.",
+					),
+				},
 			},
 			"description": schema.StringAttribute{
 				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(cafeDescriptionMaxLength),
+				},
 			},
 			"image": schema.StringAttribute{
+				CustomType: urltype.Type{},
+				Optional:   true,
+			},
+			// image_file is a local image that is uploaded to the API, which
+			// is done again whenever the checksum in image_hash changes.
+			"image_file": schema.StringAttribute{
+				Optional: true,
+			},
+			// image_content_base64 is uploaded like image_file. It is
+			// write-only, so that only its checksum in image_hash is kept
+			// in state.
+			"image_content_base64": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"image_hash": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(cafeStatuses...),
+				},
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"opening_hours": schema.SetNestedAttribute{
+				Description: "The weekly hours of the cafe. Leave this unset when the hours are managed by a hashicups_cafe_hours resource, " +
+					"as each would overwrite the hours set by the other. Planning an existing cafe with both fails.",
+				MarkdownDescription: "The weekly hours of the cafe. Leave this unset when the hours are managed by a `hashicups_cafe_hours` resource, " +
+					"as each would overwrite the hours set by the other. Planning an existing cafe with both fails.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(weekdays...),
+							},
+						},
+						"opens": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.TimeOfDay(),
+							},
+						},
+						"closes": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.TimeOfDay(),
+							},
+						},
+					},
+				},
+			},
+			"menu_items": schema.ListNestedAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"coffee_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"price": schema.StringAttribute{
+							CustomType: decimal.Type{},
+							Computed:   true,
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+			// admin_password is write-only, so it is never kept in plan or
+			// state. It is sent to the API on create and again only when
+			// admin_password_version changes.
+			"admin_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"admin_password_version": schema.StringAttribute{
+				Optional: true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			// replace_on_address_change is for deployments that treat a cafe
+			// at a new address as a new cafe.
+			"replace_on_address_change": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			// require_unique_name checks at plan time that no other cafe
+			// has the name, which costs an API request per planned name.
+			"require_unique_name": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"location": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"latitude": schema.Float64Attribute{
+						Required: true,
+						Validators: []validator.Float64{
+							float64validator.Between(-90, 90),
+						},
+					},
+					"longitude": schema.Float64Attribute{
+						Required: true,
+						Validators: []validator.Float64{
+							float64validator.Between(-180, 180),
+						},
+					},
+				},
+			},
+			// metadata is an arbitrary JSON document, compared by content so
+			// that key order and whitespace don't cause diffs.
+			"metadata": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"tax_rate_id": schema.StringAttribute{
+				Optional: true,
+			},
+			// org_id is the organization the cafe belongs to, if any.
+			"org_id": schema.StringAttribute{
+				Optional: true,
+			},
+			// price_list_id overrides the list prices of coffees at the cafe
+			// with those of a hashicups_price_list.
+			"price_list_id": schema.StringAttribute{
 				Optional: true,
 			},
+		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -71,14 +416,63 @@ func (r *cafeResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	cafe := hashicups.Cafe{
-		Name:        plan.Name.ValueString(),
-		Address:     plan.Address.ValueString(),
-		Description: plan.Description.ValueString(),
-		Image:       plan.Image.ValueString(),
+	createTimeout, diags := timeouts.Create(ctx, plan.Timeouts, defaultCafeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	cafe, diags := plan.toAPI(ctx, r.client.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// admin_password is write-only, so it is only set in the configuration.
+	var adminPassword types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("admin_password"), &adminPassword)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cafe.AdminPassword = adminPassword.ValueString()
+
+	var adoptID int
+	if plan.AdoptExisting.ValueBool() && !plan.Name.IsNull() {
+		adoptID, diags = r.findAdoptableCafe(ctx, plan.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	createdCafe, err := r.client.CreateCafe([]hashicups.Cafe{cafe})
+	var createdCafe *apiCafe
+	var etag string
+	var err error
+	if adoptID != 0 {
+		// Adopting an existing cafe reconciles it with the configuration
+		// instead of creating a second cafe with the same name.
+		tflog.Info(ctx, "Adopting existing HashiCups cafe", map[string]any{"id": adoptID})
+
+		cafe.ID = adoptID
+		createdCafe, etag, err = r.client.updateCafe(ctx, strconv.Itoa(adoptID), cafe, "")
+	} else {
+		// The same key is sent with every retry of the request, so the API
+		// creates the cafe only once even if an earlier attempt timed out
+		// after the cafe was created.
+		idempotencyKey, uuidErr := uuid.GenerateUUID()
+		if uuidErr != nil {
+			resp.Diagnostics.AddError(
+				"Error creating cafe",
+				"Could not generate idempotency key: "+uuidErr.Error(),
+			)
+			return
+		}
+
+		createdCafe, etag, err = r.client.createCafe(ctx, cafe, idempotencyKey)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating cafe",
@@ -87,17 +481,28 @@ func (r *cafeResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	plan.ID = types.StringValue(strconv.Itoa(createdCafe.ID))
-	plan.Name = types.StringValue(createdCafe.Name)
-	plan.Address = types.StringValue(createdCafe.Address)
-	plan.Description = types.StringValue(createdCafe.Description)
-	plan.Image = types.StringValue(createdCafe.Image)
+	if plan.hasUploadedImage() {
+		createdCafe, etag, diags = r.uploadImage(ctx, req.Config, plan, strconv.Itoa(createdCafe.ID), etag)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, *createdCafe, r.client.defaultTags)...)
+	resp.Diagnostics.Append(r.readMenuItems(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
 }
 
 func (r *cafeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -118,7 +523,14 @@ func (r *cafeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Assume GetCafe now returns a list of cafes
-	cafes, err := r.client.GetCafe(strconv.Itoa(cafeID))
+	cafes, etag, err := r.client.getCafe(ctx, strconv.Itoa(cafeID))
+	if isNotFound(err) || (err == nil && len(cafes) == 0) {
+		// The cafe was deleted outside of Terraform. Removing it from
+		// state lets Terraform plan to create it again.
+		tflog.Warn(ctx, "HashiCups cafe not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read HashiCups Cafe",
@@ -127,22 +539,14 @@ func (r *cafeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	if len(cafes) == 0 {
-		resp.Diagnostics.AddError(
-			"Cafe Not Found",
-			"No cafe found with the given ID",
-		)
-		return
-	}
-
 	cafe := cafes[0]
 
 	// Map response body to model
-	state.ID = types.StringValue(strconv.Itoa(cafe.ID))
-	state.Address = types.StringValue(cafe.Address)
-	state.Image = types.StringValue(cafe.Image)
-	state.Name = types.StringValue(cafe.Name)
-	state.Description = types.StringValue(cafe.Description)
+	resp.Diagnostics.Append(state.fromAPI(ctx, cafe, r.client.defaultTags)...)
+	resp.Diagnostics.Append(r.readMenuItems(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -150,6 +554,8 @@ func (r *cafeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
 }
 
 func (r *cafeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -160,6 +566,15 @@ func (r *cafeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := timeouts.Update(ctx, plan.Timeouts, defaultCafeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Convert the ID from string to int
 	cafeID, err := strconv.Atoi(plan.ID.ValueString())
 	if err != nil {
@@ -171,16 +586,45 @@ func (r *cafeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Create a cafe object
-	cafe := hashicups.Cafe{
-		ID:          cafeID, // ID is an int
-		Name:        plan.Name.ValueString(),
-		Address:     plan.Address.ValueString(),
-		Description: plan.Description.ValueString(),
-		Image:       plan.Image.ValueString(),
+	cafe, diags := plan.toAPI(ctx, r.client.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cafe.ID = cafeID
+
+	var state cafeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The admin password is only rotated when its version changes.
+	if !plan.AdminPasswordVersion.Equal(state.AdminPasswordVersion) {
+		var adminPassword types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("admin_password"), &adminPassword)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		cafe.AdminPassword = adminPassword.ValueString()
+	}
+
+	ifMatch, diags := getPrivateString(ctx, req.Private, etagPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Update the existing cafe
-	updatedCafe, err := r.client.UpdateCafe(plan.ID.ValueString(), []hashicups.Cafe{cafe})
+	updatedCafe, etag, err := r.client.updateCafe(ctx, plan.ID.ValueString(), cafe, ifMatch)
+	if isPreconditionFailed(err) {
+		resp.Diagnostics.AddError(
+			"HashiCups Cafe Modified Outside Terraform",
+			"The cafe was changed since Terraform last read it, so the update was rejected to avoid overwriting those changes. "+
+				"Refresh the state, review the differences and apply again.",
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating HashiCups Cafe",
@@ -189,18 +633,29 @@ func (r *cafeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	if plan.hasUploadedImage() && !plan.ImageHash.Equal(state.ImageHash) {
+		updatedCafe, etag, diags = r.uploadImage(ctx, req.Config, plan, plan.ID.ValueString(), etag)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Update resource state with updated items
-	plan.ID = types.StringValue(strconv.Itoa(updatedCafe.ID))
-	plan.Name = types.StringValue(updatedCafe.Name)
-	plan.Address = types.StringValue(updatedCafe.Address)
-	plan.Description = types.StringValue(updatedCafe.Description)
-	plan.Image = types.StringValue(updatedCafe.Image)
+	resp.Diagnostics.Append(plan.fromAPI(ctx, *updatedCafe, r.client.defaultTags)...)
+	resp.Diagnostics.Append(r.readMenuItems(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
 }
 
 func (r *cafeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -211,6 +666,25 @@ func (r *cafeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_protection"),
+			"HashiCups Cafe Deletion Protected",
+			"The cafe cannot be deleted while deletion_protection is enabled. "+
+				"Set deletion_protection to false and apply that change before destroying or replacing the cafe.",
+		)
+		return
+	}
+
+	deleteTimeout, diags := timeouts.Delete(ctx, state.Timeouts, defaultCafeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	cafeID, err := strconv.Atoi(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -220,7 +694,36 @@ func (r *cafeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err = r.client.DeleteCafe(strconv.Itoa(cafeID))
+	// The API refuses to delete a cafe that still has orders.
+	if state.ForceDestroy.ValueBool() {
+		resp.Diagnostics.Append(r.deleteCafeOrders(ctx, state.ID.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ifMatch, diags := getPrivateString(ctx, req.Private, etagPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.client.deleteCafe(ctx, strconv.Itoa(cafeID), ifMatch)
+	if isPreconditionFailed(err) {
+		resp.Diagnostics.AddError(
+			"HashiCups Cafe Modified Outside Terraform",
+			"The cafe was changed since Terraform last read it, so the delete was rejected. "+
+				"Refresh the state, review the differences and apply again.",
+		)
+		return
+	}
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Cafe Already Deleted",
+			"The cafe was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting HashiCups Cafe",
@@ -230,17 +733,363 @@ func (r *cafeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// cafeResourceModelV0 maps the state of schema version 0, from before the
+// cafe resource was versioned.
+type cafeResourceModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Address     types.String `tfsdk:"address"`
+	Description types.String `tfsdk:"description"`
+	Image       types.String `tfsdk:"image"`
+}
+
+// UpgradeState migrates state written by earlier versions of the cafe schema
+// to the current version.
+func (r *cafeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+					"address": schema.StringAttribute{
+						Optional: true,
+					},
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+					"image": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			StateUpgrader: upgradeCafeStateV0,
+		},
+	}
+}
+
+// upgradeCafeStateV0 upgrades version 0 state, which only held the cafe's
+// basic fields. The attributes added since are left null and filled in by the
+// refresh that follows the upgrade.
+func upgradeCafeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior cafeResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(prior.setState(ctx, &resp.State)...)
+}
+
+// setState sets the attributes of a version 0 cafe on state, which uses the
+// current schema. All other attributes are left null.
+func (m cafeResourceModelV0) setState(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, value := range map[string]types.String{
+		"id":          m.ID,
+		"name":        m.Name,
+		"address":     m.Address,
+		"description": m.Description,
+		"image":       m.Image,
+	} {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	return diags
+}
+
+// legacyCafeState is the state of the legacy SDKv2 cafe resource, which
+// stored the cafe ID as a number.
+type legacyCafeState struct {
+	ID          json.Number `json:"id"`
+	Name        *string     `json:"name"`
+	Address     *string     `json:"address"`
+	Description *string     `json:"description"`
+	Image       *string     `json:"image"`
+}
+
+// MoveState lets a `moved` block move a cafe from the legacy SDKv2-based
+// provider's hashicups_cafe resource to this one without recreating it.
+func (r *cafeResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveLegacyCafeState,
+		},
+	}
+}
+
+// moveLegacyCafeState moves hashicups_cafe state. Requests for any other
+// source type are left to the remaining state movers.
+func moveLegacyCafeState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != legacyCafeTypeName || req.SourceRawState == nil {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(req.SourceRawState.JSON))
+	decoder.UseNumber()
+
+	var legacy legacyCafeState
+	if err := decoder.Decode(&legacy); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move HashiCups Cafe State",
+			fmt.Sprintf("Could not decode the %s state: %s", legacyCafeTypeName, err),
+		)
+		return
+	}
+
+	if _, err := strconv.Atoi(legacy.ID.String()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move HashiCups Cafe State",
+			fmt.Sprintf("Expected the %s state to hold a numeric cafe ID, got: %q", legacyCafeTypeName, legacy.ID.String()),
+		)
+		return
+	}
+
+	moved := cafeResourceModelV0{
+		ID:          types.StringValue(legacy.ID.String()),
+		Name:        types.StringPointerValue(legacy.Name),
+		Address:     types.StringPointerValue(legacy.Address),
+		Description: types.StringPointerValue(legacy.Description),
+		Image:       types.StringPointerValue(legacy.Image),
+	}
+
+	resp.Diagnostics.Append(moved.setState(ctx, &resp.TargetState)...)
+}
+
+// ModifyPlan plans image_hash as the checksum of the image to upload, and
+// tags_all as the cafe's tags merged over the provider's default tags, so
+// that changes to either show up in the plan. When require_unique_name is
+// true, it also fails the plan if another cafe already has the planned name,
+// and it fails the plan of a cafe that sets opening_hours while a
+// hashicups_cafe_hours resource manages its hours.
+func (r *cafeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the cafe is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planImageHash(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planTagsAll(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planUniqueName(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planOpeningHours(ctx, req, resp)
+}
+
+// planTagsAll sets tags_all to the cafe's tags merged over the provider's
+// default tags. It is left unknown while the provider is not configured yet
+// or any tag is unknown.
+func (r *cafeResource) planTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var planTags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &planTags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planTags.IsUnknown() {
+		return
+	}
+	for _, value := range planTags.Elements() {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	var tags map[string]string
+	resp.Diagnostics.Append(planTags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, mergeTags(r.client.defaultTags, tags))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// planUniqueName fails the plan when require_unique_name is true and another
+// cafe already has the planned name, instead of the apply failing part way.
+// The name is checked when the cafe is created, unless it adopts an existing
+// cafe, and when it is renamed.
+func (r *cafeResource) planUniqueName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var name normalizedstring.Value
+	var requireUniqueName, adoptExisting types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("require_unique_name"), &requireUniqueName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("adopt_existing"), &adoptExisting)...)
+	if resp.Diagnostics.HasError() || !requireUniqueName.ValueBool() || name.IsNull() || name.IsUnknown() {
+		return
+	}
+
+	var id types.String
+	if req.State.Raw.IsNull() {
+		if adoptExisting.ValueBool() {
+			return
+		}
+	} else {
+		var stateName normalizedstring.Value
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		if resp.Diagnostics.HasError() || normalizedstring.Equal(stateName.ValueString(), name.ValueString()) {
+			return
+		}
+	}
+
+	var cafes []hashicups.Cafe
+	err := r.client.do(ctx, http.MethodGet, "/cafes", nil, &cafes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Cafes",
+			"Could not check whether the cafe name is unique: "+err.Error(),
+		)
+		return
+	}
+
+	for _, cafe := range cafes {
+		if strconv.Itoa(cafe.ID) != id.ValueString() && normalizedstring.Equal(cafe.Name, name.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Duplicate Cafe Name",
+				fmt.Sprintf("A cafe named %q already exists (ID: %d). Choose another name, or import the existing cafe.", cafe.Name, cafe.ID),
+			)
+			return
+		}
+	}
+}
+
+// planOpeningHours fails the plan when opening_hours is set for a cafe whose
+// hours are managed by a hashicups_cafe_hours resource, as the two are
+// mutually exclusive and each would overwrite the hours set by the other on
+// every apply. The API only has an hours object at /cafes/{id}/hours for
+// hours set through it, and returns 404 otherwise. New cafes are not
+// checked, as no other resource can manage their hours yet, so a
+// configuration that creates both fails on its next plan.
+func (r *cafeResource) planOpeningHours(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.State.Raw.IsNull() {
+		return
+	}
+
+	var openingHours types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("opening_hours"), &openingHours)...)
+	if resp.Diagnostics.HasError() || openingHours.IsNull() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodGet, "/cafes/"+id.ValueString()+"/hours", nil, nil)
+	if isNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Cafe Hours",
+			"Could not check whether the hours of HashiCups cafe ID "+id.ValueString()+" are managed separately: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("opening_hours"),
+		"Cafe Hours Managed Separately",
+		"The hours of this cafe are managed by a hashicups_cafe_hours resource, which overwrites the opening_hours of the cafe and is overwritten by them in turn. "+
+			"Remove opening_hours from the cafe, or remove the hashicups_cafe_hours resource.",
+	)
+}
+
+// replaceOnAddressChange requires the cafe to be replaced instead of updated
+// in place when its address changes, if replace_on_address_change is
+// planned as true.
+func replaceOnAddressChange(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var replace types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replace_on_address_change"), &replace)...)
+
+	resp.RequiresReplace = replace.ValueBool()
+}
+
+// ConfigValidators allows the image to be set by only one of its URL, a
+// file, or inline content.
+func (r *cafeResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		configvalidator.Conflicting(
+			path.MatchRoot("image"),
+			path.MatchRoot("image_file"),
+			path.MatchRoot("image_content_base64"),
+		),
+	}
+}
+
+// ValidateConfig checks the rules that span several attributes: the name
+// must give a non-empty slug, and a cafe with an image must have a
+// description.
+func (r *cafeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var name normalizedstring.Value
+	var image urltype.Value
+	var description, imageFile, imageContentBase64 types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("description"), &description)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("image"), &image)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("image_file"), &imageFile)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("image_content_base64"), &imageContentBase64)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// name is optional, so only a name that is set is checked.
+	if !name.IsNull() && !name.IsUnknown() && slugify(name.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Missing Cafe Name",
+			"The cafe name must contain at least one letter or digit, as the cafe's slug is derived from it.",
+		)
+	}
+
+	hasImage := !image.IsNull() || !imageFile.IsNull() || !imageContentBase64.IsNull()
+	if hasImage && description.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Missing Cafe Description",
+			"A cafe with an image must also have a description, which is used as the image's alternative text.",
+		)
+	}
+}
+
 func (r *cafeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*hashicups.Client)
+	client, ok := req.ProviderData.(*apiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -248,3 +1097,420 @@ func (r *cafeResource) Configure(_ context.Context, req resource.ConfigureReques
 
 	r.client = client
 }
+
+// ImportState imports a cafe either by its numeric ID or, when the import ID
+// is prefixed with "name=", by looking the cafe up by its exact name.
+func (r *cafeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, byName := strings.CutPrefix(req.ID, cafeImportNamePrefix)
+	if !byName {
+		if _, err := strconv.Atoi(req.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Cafe Import ID",
+				fmt.Sprintf("Expected a numeric cafe ID or %q followed by the cafe name, got: %q", cafeImportNamePrefix, req.ID),
+			)
+			return
+		}
+
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	matches, err := findCafesByName(ctx, r.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Cafes",
+			err.Error(),
+		)
+		return
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Cafe Not Found",
+			fmt.Sprintf("No cafe found with the name %q.", name),
+		)
+		return
+	case 1:
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, cafe := range matches {
+			ids = append(ids, strconv.Itoa(cafe.ID))
+		}
+
+		resp.Diagnostics.AddError(
+			"Multiple Cafes Found",
+			fmt.Sprintf("Found %d cafes with the name %q (IDs: %s). Import the cafe by its numeric ID instead.",
+				len(matches), name, strings.Join(ids, ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(matches[0].ID))...)
+}
+
+// findCafesByName returns every cafe whose name exactly matches name.
+func findCafesByName(ctx context.Context, client *apiClient, name string) ([]hashicups.Cafe, error) {
+	var cafes []hashicups.Cafe
+	err := client.do(ctx, http.MethodGet, "/cafes", nil, &cafes)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []hashicups.Cafe
+	for _, cafe := range cafes {
+		if cafe.Name == name {
+			matches = append(matches, cafe)
+		}
+	}
+
+	return matches, nil
+}
+
+// findAdoptableCafe returns the ID of the existing cafe named name, for
+// adopt_existing, or 0 when there is none. More than one cafe
+// with the name is an error, as it is unclear which one to adopt.
+func (r *cafeResource) findAdoptableCafe(ctx context.Context, name string) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matches, err := findCafesByName(ctx, r.client, name)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read HashiCups Cafes",
+			"Could not look up existing cafes to adopt: "+err.Error(),
+		)
+		return 0, diags
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, diags
+	case 1:
+		return matches[0].ID, diags
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, cafe := range matches {
+			ids = append(ids, strconv.Itoa(cafe.ID))
+		}
+
+		diags.AddAttributeError(
+			path.Root("adopt_existing"),
+			"Multiple Cafes Found",
+			fmt.Sprintf("Found %d cafes with the name %q (IDs: %s), so none of them can be adopted. Import the cafe by its numeric ID instead.",
+				len(matches), name, strings.Join(ids, ", ")),
+		)
+		return 0, diags
+	}
+}
+
+// deleteCafeOrders deletes every order placed at the cafe, for force_destroy.
+func (r *cafeResource) deleteCafeOrders(ctx context.Context, cafeID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	orders, err := r.client.getCafeOrders(ctx, cafeID)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read HashiCups Cafe Orders",
+			"Could not list the orders of HashiCups cafe ID "+cafeID+" to force destroy it: "+err.Error(),
+		)
+		return diags
+	}
+
+	for _, order := range orders {
+		orderID := strconv.Itoa(order.ID)
+		tflog.Debug(ctx, "Deleting HashiCups order to force destroy cafe", map[string]any{
+			"cafe_id":  cafeID,
+			"order_id": orderID,
+		})
+
+		err := r.client.deleteOrder(ctx, orderID)
+		if err != nil && !isNotFound(err) {
+			diags.AddError(
+				"Error Deleting HashiCups Order",
+				"Could not delete order ID "+orderID+" of HashiCups cafe ID "+cafeID+" to force destroy it: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// readMenuItems sets menu_items to the cafe's current menu. The menu only
+// holds coffee IDs and price overrides, so names and list prices come from
+// the coffee catalog.
+func (r *cafeResource) readMenuItems(ctx context.Context, m *cafeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	menu, _, err := r.client.getMenu(ctx, m.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		diags.AddError(
+			"Unable to Read HashiCups Cafe Menu",
+			"Could not read menu for HashiCups cafe ID "+m.ID.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	items := []cafeMenuItemModel{}
+	if len(menu.Items) > 0 {
+		coffees, err := r.client.getCoffees(ctx)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read HashiCups Coffees",
+				err.Error(),
+			)
+			return diags
+		}
+
+		byID := make(map[int]hashicups.Coffee, len(coffees))
+		for _, coffee := range coffees {
+			byID[coffee.ID] = coffee
+		}
+
+		for _, item := range menu.Items {
+			coffee := byID[item.CoffeeID]
+			price := decimal.NewFloat64Value(coffee.Price)
+			if item.Price != nil {
+				price = decimal.NewValue(item.Price.String())
+			}
+
+			items = append(items, cafeMenuItemModel{
+				CoffeeID: types.Int64Value(int64(item.CoffeeID)),
+				Name:     types.StringValue(coffee.Name),
+				Price:    price,
+			})
+		}
+	}
+
+	m.MenuItems, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: cafeMenuItemAttrTypes}, items)
+
+	return diags
+}
+
+// toAPI builds the API request body from the resource model. The cafe's
+// labels are its tags merged over the provider's default tags. They are
+// always sent, as an empty map when there are none, so that removing the last
+// tag also clears it in the API.
+func (m cafeResourceModel) toAPI(ctx context.Context, defaultTags map[string]string) (apiCafe, diag.Diagnostics) {
+	cafe := apiCafe{
+		Name:        m.Name.ValueString(),
+		Address:     m.Address.ValueString(),
+		Description: m.Description.ValueString(),
+		Status:      m.Status.ValueString(),
+	}
+
+	// While the image is uploaded it is left out, so that saving the cafe
+	// keeps the uploaded image.
+	if !m.hasUploadedImage() {
+		image := m.Image.ValueString()
+		cafe.Image = &image
+	}
+
+	// Without opening_hours the hours are left out, so that they can be
+	// managed by a hashicups_cafe_hours resource instead.
+	if m.OpeningHours != nil {
+		openingHours := []apiOpeningHours{}
+		for _, hours := range m.OpeningHours {
+			openingHours = append(openingHours, apiOpeningHours{
+				Day:    hours.Day.ValueString(),
+				Opens:  hours.Opens.ValueString(),
+				Closes: hours.Closes.ValueString(),
+			})
+		}
+		cafe.OpeningHours = &openingHours
+	}
+
+	if m.Location != nil {
+		cafe.Location = &apiLocation{
+			Latitude:  m.Location.Latitude.ValueFloat64(),
+			Longitude: m.Location.Longitude.ValueFloat64(),
+		}
+	}
+
+	if !m.Metadata.IsNull() {
+		cafe.Metadata = json.RawMessage(m.Metadata.ValueString())
+	}
+
+	var diags diag.Diagnostics
+	if !m.TaxRateID.IsNull() {
+		taxRateID := parseObjectID(path.Root("tax_rate_id"), m.TaxRateID.ValueString(), &diags)
+		cafe.TaxRateID = &taxRateID
+	}
+	if !m.OrgID.IsNull() {
+		orgID := parseObjectID(path.Root("org_id"), m.OrgID.ValueString(), &diags)
+		cafe.OrgID = &orgID
+	}
+	if !m.PriceListID.IsNull() {
+		priceListID := parseObjectID(path.Root("price_list_id"), m.PriceListID.ValueString(), &diags)
+		cafe.PriceListID = &priceListID
+	}
+
+	var tags map[string]string
+	diags.Append(m.Tags.ElementsAs(ctx, &tags, false)...)
+	cafe.Labels = mergeTags(defaultTags, tags)
+
+	return cafe, diags
+}
+
+// fromAPI maps an API response body onto the resource model. tags_all holds
+// every label of the cafe, while tags leaves out those inherited from the
+// provider's default tags. The API does not distinguish between no labels and
+// an empty set of labels, so tags stay null when they are not configured and
+// none are returned.
+func (m *cafeResourceModel) fromAPI(ctx context.Context, cafe apiCafe, defaultTags map[string]string) diag.Diagnostics {
+	m.ID = types.StringValue(strconv.Itoa(cafe.ID))
+	m.Name = normalizedstring.NewValue(cafe.Name)
+	m.Slug = types.StringValue(slugify(cafe.Name))
+	m.Address = normalizedstring.NewValue(cafe.Address)
+	// description stays null when it is not set and the cafe has none.
+	if !m.Description.IsNull() || cafe.Description != "" {
+		m.Description = types.StringValue(cafe.Description)
+	}
+	// image stays null when the cafe has none, as an empty string is not a
+	// valid URL.
+	if !m.hasUploadedImage() {
+		m.Image = urltype.NewNull()
+		if cafe.Image != nil && *cafe.Image != "" {
+			m.Image = urltype.NewValue(*cafe.Image)
+		}
+	}
+	m.Status = types.StringValue(cafe.Status)
+	m.CreatedAt = types.StringValue(cafe.CreatedAt)
+	m.UpdatedAt = types.StringValue(cafe.UpdatedAt)
+	m.Owner = types.StringValue(cafe.Owner)
+
+	// opening_hours stays null unless it is configured, as the hours may be
+	// managed by a hashicups_cafe_hours resource instead.
+	if m.OpeningHours != nil && cafe.OpeningHours != nil {
+		m.OpeningHours = []openingHoursModel{}
+		for _, hours := range *cafe.OpeningHours {
+			m.OpeningHours = append(m.OpeningHours, openingHoursModel{
+				Day:    types.StringValue(hours.Day),
+				Opens:  types.StringValue(hours.Opens),
+				Closes: types.StringValue(hours.Closes),
+			})
+		}
+	}
+
+	m.Location = nil
+	if cafe.Location != nil {
+		m.Location = &locationModel{
+			Latitude:  types.Float64Value(cafe.Location.Latitude),
+			Longitude: types.Float64Value(cafe.Location.Longitude),
+		}
+	}
+
+	m.Metadata = jsontypes.NewNormalizedNull()
+	if len(cafe.Metadata) > 0 && !bytes.Equal(cafe.Metadata, []byte("null")) {
+		m.Metadata = jsontypes.NewNormalizedValue(string(cafe.Metadata))
+	}
+
+	m.TaxRateID = types.StringNull()
+	if cafe.TaxRateID != nil {
+		m.TaxRateID = types.StringValue(strconv.Itoa(*cafe.TaxRateID))
+	}
+	m.OrgID = types.StringNull()
+	if cafe.OrgID != nil {
+		m.OrgID = types.StringValue(strconv.Itoa(*cafe.OrgID))
+	}
+	m.PriceListID = types.StringNull()
+	if cafe.PriceListID != nil {
+		m.PriceListID = types.StringValue(strconv.Itoa(*cafe.PriceListID))
+	}
+
+	labels := cafe.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, labels)
+	m.TagsAll = tagsAll
+
+	var configured map[string]string
+	diags.Append(m.Tags.ElementsAs(ctx, &configured, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tags := resourceTags(labels, defaultTags, configured)
+	if len(tags) == 0 {
+		if !m.Tags.IsNull() {
+			m.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{})
+		}
+		return diags
+	}
+
+	tagsValue, d := types.MapValueFrom(ctx, types.StringType, tags)
+	diags.Append(d...)
+	m.Tags = tagsValue
+
+	return diags
+}
+
+// getCafe is the context-aware counterpart of GetCafe. The API responds with
+// a list holding the single matching cafe, and the cafe's ETag.
+func (c *apiClient) getCafe(ctx context.Context, cafeID string) ([]apiCafe, string, error) {
+	cafes := []apiCafe{}
+	header, err := c.send(ctx, http.MethodGet, "/cafes/"+cafeID, nil, nil, &cafes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return cafes, header.Get("ETag"), nil
+}
+
+// createCafe is the context-aware counterpart of CreateCafe. Requests with
+// the same idempotencyKey create at most one cafe. It also returns the ETag
+// of the created cafe.
+func (c *apiClient) createCafe(ctx context.Context, cafe apiCafe, idempotencyKey string) (*apiCafe, string, error) {
+	reqHeader := http.Header{idempotencyKeyHeader: []string{idempotencyKey}}
+
+	var created apiCafe
+	header, err := c.send(ctx, http.MethodPost, "/cafes", reqHeader, []apiCafe{cafe}, &created)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &created, header.Get("ETag"), nil
+}
+
+// updateCafe is the context-aware counterpart of UpdateCafe. When ifMatch is
+// not empty the update only succeeds if the cafe still has that ETag. It
+// returns the cafe's new ETag.
+func (c *apiClient) updateCafe(ctx context.Context, cafeID string, cafe apiCafe, ifMatch string) (*apiCafe, string, error) {
+	var updated apiCafe
+	header, err := c.send(ctx, http.MethodPut, "/cafes/"+cafeID, ifMatchHeader(ifMatch), []apiCafe{cafe}, &updated)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &updated, header.Get("ETag"), nil
+}
+
+// deleteCafe is the context-aware counterpart of DeleteCafe. When ifMatch is
+// not empty the cafe is only deleted if it still has that ETag.
+func (c *apiClient) deleteCafe(ctx context.Context, cafeID string, ifMatch string) error {
+	_, err := c.send(ctx, http.MethodDelete, "/cafes/"+cafeID, ifMatchHeader(ifMatch), nil, nil)
+
+	return err
+}
+
+// getCafeOrders returns the orders placed at the cafe.
+func (c *apiClient) getCafeOrders(ctx context.Context, cafeID string) ([]hashicups.Order, error) {
+	orders := []hashicups.Order{}
+	if err := c.do(ctx, http.MethodGet, "/cafes/"+cafeID+"/orders", nil, &orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// getCoffees is the context-aware counterpart of GetCoffees.
+func (c *apiClient) getCoffees(ctx context.Context) ([]hashicups.Coffee, error) {
+	coffees := []hashicups.Coffee{}
+	if err := c.do(ctx, http.MethodGet, "/coffees", nil, &coffees); err != nil {
+		return nil, err
+	}
+
+	return coffees, nil
+}
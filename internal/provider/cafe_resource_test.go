@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCafeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCafeResourceConfig("tf-acc-test-cafe", "1 Test Street"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inpyu_cafe.test", "name", "tf-acc-test-cafe"),
+					resource.TestCheckResourceAttr("inpyu_cafe.test", "address", "1 Test Street"),
+					resource.TestCheckResourceAttrSet("inpyu_cafe.test", "id"),
+					resource.TestCheckResourceAttrSet("inpyu_cafe.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "inpyu_cafe.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API does not return last_updated or the arguments that
+				// only change how the provider manages the cafe, so they
+				// are not set on import.
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+					"adopt_existing",
+					"deletion_protection",
+					"force_destroy",
+					"replace_on_address_change",
+					"require_unique_name",
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCafeResourceConfig("tf-acc-test-cafe", "2 Test Street"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("inpyu_cafe.test", "address", "2 Test Street"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCafeResourceConfig(name, address string) string {
+	return `
+resource "inpyu_cafe" "test" {
+  name    = "` + name + `"
+  address = "` + address + `"
+}
+`
+}
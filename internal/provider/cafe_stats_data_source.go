@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &cafeStatsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &cafeStatsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &cafeStatsDataSource{}
+)
+
+// NewCafeStatsDataSource is a helper function to simplify the provider implementation.
+func NewCafeStatsDataSource() datasource.DataSource {
+	return &cafeStatsDataSource{}
+}
+
+// cafeStatsDataSource is the data source implementation.
+type cafeStatsDataSource struct {
+	client *apiClient
+}
+
+// cafeStatsDataSourceModel maps the data source schema data.
+type cafeStatsDataSourceModel struct {
+	CafeID        types.String  `tfsdk:"cafe_id"`
+	StartsAt      types.String  `tfsdk:"starts_at"`
+	EndsAt        types.String  `tfsdk:"ends_at"`
+	OrderCount    types.Int64   `tfsdk:"order_count"`
+	Revenue       decimal.Value `tfsdk:"revenue"`
+	ReviewCount   types.Int64   `tfsdk:"review_count"`
+	AverageRating types.Float64 `tfsdk:"average_rating"`
+}
+
+// apiCafeStats is the API representation of the aggregates of the orders and
+// reviews of a cafe, or of every cafe, over a time window. AverageRating is
+// nil when there are no reviews in the window.
+type apiCafeStats struct {
+	OrderCount    int64       `json:"order_count"`
+	Revenue       json.Number `json:"revenue"`
+	ReviewCount   int64       `json:"review_count"`
+	AverageRating *float64    `json:"average_rating"`
+}
+
+// Metadata returns the data source type name.
+func (d *cafeStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cafe_stats"
+}
+
+// Schema defines the schema for the data source.
+func (d *cafeStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// cafe_id selects the cafe to aggregate. Every cafe of the
+			// organization is aggregated when it is not set.
+			"cafe_id": schema.StringAttribute{
+				Optional: true,
+			},
+			// starts_at and ends_at limit the aggregates to the orders and
+			// reviews in the window, inclusive of starts_at. The window is
+			// unbounded on a side that is not set.
+			"starts_at": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			"order_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			// revenue is the total of the orders in the window.
+			"revenue": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Computed:   true,
+			},
+			"review_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			// average_rating is null when there are no reviews in the
+			// window.
+			"average_rating": schema.Float64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the time window ends after it starts.
+func (d *cafeStatsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var startsAt, endsAt types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("starts_at"), &startsAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ends_at"), &endsAt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeWindow(startsAt, endsAt, path.Root("ends_at"), "Invalid Statistics Window", &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the aggregates the API computes for
+// the cafe and time window.
+func (d *cafeStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state cafeStatsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	for name, value := range map[string]types.String{
+		"cafe_id":   state.CafeID,
+		"starts_at": state.StartsAt,
+		"ends_at":   state.EndsAt,
+	} {
+		if !value.IsNull() {
+			query.Set(name, value.ValueString())
+		}
+	}
+
+	apiPath := "/stats"
+	if len(query) > 0 {
+		apiPath += "?" + query.Encode()
+	}
+
+	var stats apiCafeStats
+	err := d.client.do(ctx, http.MethodGet, apiPath, nil, &stats)
+	if isNotFound(err) && !state.CafeID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cafe_id"),
+			"Cafe Not Found",
+			fmt.Sprintf("No cafe found with the ID %q.", state.CafeID.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Cafe Statistics",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	revenue := stats.Revenue
+	if revenue == "" {
+		revenue = "0"
+	}
+
+	state.OrderCount = types.Int64Value(stats.OrderCount)
+	state.Revenue = decimal.NewValue(revenue.String())
+	state.ReviewCount = types.Int64Value(stats.ReviewCount)
+	state.AverageRating = types.Float64PointerValue(stats.AverageRating)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *cafeStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/datasourcefilter"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cafesDataSource{}
+	_ datasource.DataSourceWithConfigure = &cafesDataSource{}
+)
+
+// NewCafesDataSource is a helper function to simplify the provider implementation.
+func NewCafesDataSource() datasource.DataSource {
+	return &cafesDataSource{}
+}
+
+// cafesDataSource is the data source implementation.
+type cafesDataSource struct {
+	client *apiClient
+}
+
+// cafesDataSourceModel maps the data source schema data.
+type cafesDataSourceModel struct {
+	NameRegex   types.String             `tfsdk:"name_regex"`
+	MaxItems    types.Int64              `tfsdk:"max_items"`
+	PageSize    types.Int64              `tfsdk:"page_size"`
+	SortBy      types.String             `tfsdk:"sort_by"`
+	SortOrder   types.String             `tfsdk:"sort_order"`
+	Filters     []datasourcefilter.Model `tfsdk:"filter"`
+	Cafes       []cafesModel             `tfsdk:"cafes"`
+	CafesByName map[string]cafesModel    `tfsdk:"cafes_by_name"`
+}
+
+// cafesModel maps cafes schema data.
+type cafesModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Address     types.String `tfsdk:"address"`
+	Description types.String `tfsdk:"description"`
+	Image       types.String `tfsdk:"image"`
+}
+
+// cafesSortFields are the fields the cafes can be sorted by.
+var cafesSortFields = sortFields[hashicups.Cafe]{
+	"id": func(a, b hashicups.Cafe) int {
+		return cmp.Compare(a.ID, b.ID)
+	},
+	"name": func(a, b hashicups.Cafe) int {
+		return cmp.Compare(a.Name, b.Name)
+	},
+	"address": func(a, b hashicups.Cafe) int {
+		return cmp.Compare(a.Address, b.Address)
+	},
+}
+
+// Metadata returns the data source type name.
+func (d *cafesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cafes"
+}
+
+// Schema defines the schema for the data source.
+func (d *cafesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	cafeAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"address": schema.StringAttribute{
+			Computed: true,
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"image": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// name_regex keeps the cafes whose name the regular
+			// expression matches anywhere, as in the aws_ami data source.
+			// Anchor it with ^ and $ to match the whole name.
+			"name_regex": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.Regex(),
+				},
+			},
+			"max_items":  maxItemsAttribute(),
+			"page_size":  pageSizeAttribute(),
+			"sort_by":    sortByAttribute(cafesSortFields),
+			"sort_order": sortOrderAttribute(),
+			"cafes": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: cafeAttributes,
+				},
+			},
+			// cafes_by_name holds the same cafes keyed by name, so that they can
+			// be indexed directly. The first of several cafes with the same name
+			// is kept.
+			"cafes_by_name": schema.MapNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: cafeAttributes,
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": datasourcefilter.Block("id", "name", "address"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cafesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state cafesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// name_regex is validated at plan time, but may have been unknown then.
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	cafes, err := listSorted(ctx, d.client, "/cafes", nil, newListOptions(state.MaxItems, state.PageSize), cafesSortFields, state.SortBy, state.SortOrder, func(cafe hashicups.Cafe) bool {
+		if nameRegex != nil && !nameRegex.MatchString(cafe.Name) {
+			return false
+		}
+
+		return datasourcefilter.Match(state.Filters, map[string]string{
+			"id":      strconv.Itoa(cafe.ID),
+			"name":    cafe.Name,
+			"address": cafe.Address,
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Cafes",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Cafes = []cafesModel{}
+	for _, cafe := range cafes {
+		state.Cafes = append(state.Cafes, cafesModel{
+			ID:          types.StringValue(strconv.Itoa(cafe.ID)),
+			Name:        types.StringValue(cafe.Name),
+			Address:     types.StringValue(cafe.Address),
+			Description: types.StringValue(cafe.Description),
+			Image:       types.StringValue(cafe.Image),
+		})
+	}
+
+	var duplicates []string
+	state.CafesByName, duplicates = indexByName(state.Cafes, func(cafe cafesModel) string {
+		return cafe.Name.ValueString()
+	})
+	if len(duplicates) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Duplicate Cafe Names",
+			fmt.Sprintf("Several cafes share the names %q. Only the first cafe with each name is in cafes_by_name.", duplicates),
+		)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *cafesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultCircuitBreakerThreshold is how many consecutive failed requests
+	// open the circuit when the provider configuration does not set
+	// circuit_breaker_threshold.
+	defaultCircuitBreakerThreshold = 5
+
+	// defaultCircuitBreakerCooldown is how long the circuit stays open when
+	// the provider configuration does not set circuit_breaker_cooldown.
+	defaultCircuitBreakerCooldown = 30 * time.Second
+)
+
+// errBackendUnavailable is returned for requests rejected by an open circuit.
+var errBackendUnavailable = errors.New("HashiCups backend unavailable")
+
+// circuitBreakerTransport is an http.RoundTripper that stops sending
+// requests once Threshold consecutive requests have failed, so that the
+// remaining operations of an apply fail immediately instead of each waiting
+// for their own timeouts and retries. After Cooldown a single trial request
+// is let through: its success closes the circuit again, its failure keeps it
+// open for another cooldown.
+type circuitBreakerTransport struct {
+	Base      http.RoundTripper
+	Threshold int
+	Cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Threshold <= 0 {
+		return t.Base.RoundTrip(req)
+	}
+
+	if err := t.allow(); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	res, err := t.Base.RoundTrip(req)
+	if opened := t.record(retryable(res, err)); opened {
+		tflog.Warn(req.Context(), "Opened HashiCups API circuit breaker", map[string]any{
+			"threshold": t.Threshold,
+			"cooldown":  t.Cooldown.String(),
+		})
+	}
+
+	return res, err
+}
+
+// allow returns an error when the circuit is open and the request must not
+// be sent.
+func (t *circuitBreakerTransport) allow() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.failures < t.Threshold {
+		return nil
+	}
+
+	if time.Now().Before(t.openUntil) || t.trial {
+		return fmt.Errorf("%w: %d consecutive requests failed, not sending further requests until %s",
+			errBackendUnavailable, t.failures, t.openUntil.Format(time.RFC3339))
+	}
+
+	t.trial = true
+
+	return nil
+}
+
+// record tracks the outcome of a request and reports whether it opened the
+// circuit.
+func (t *circuitBreakerTransport) record(failed bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.trial = false
+
+	if !failed {
+		t.failures = 0
+		return false
+	}
+
+	t.failures++
+	if t.failures < t.Threshold {
+		return false
+	}
+
+	t.openUntil = time.Now().Add(t.Cooldown)
+
+	return true
+}
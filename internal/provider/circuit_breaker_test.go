@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTransport(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	healthy := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if !healthy {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	transport := &circuitBreakerTransport{
+		Base:      http.DefaultTransport,
+		Threshold: 2,
+		Cooldown:  50 * time.Millisecond,
+	}
+	client := &http.Client{Transport: transport}
+
+	get := func() error {
+		res, err := client.Get(server.URL)
+		if err != nil {
+			return err
+		}
+		res.Body.Close()
+		return nil
+	}
+
+	// The first two failures reach the server and open the circuit.
+	for i := 0; i < 2; i++ {
+		if err := get(); err != nil {
+			t.Fatalf("request %d: unexpected error: %s", i, err)
+		}
+	}
+
+	if err := get(); !errors.Is(err, errBackendUnavailable) {
+		t.Fatalf("expected errBackendUnavailable, got: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected the open circuit to reject the request, got %d calls", calls)
+	}
+
+	// After the cooldown a successful trial request closes the circuit.
+	healthy = true
+	time.Sleep(60 * time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		if err := get(); err != nil {
+			t.Fatalf("request %d after cooldown: unexpected error: %s", i, err)
+		}
+	}
+
+	if calls != 5 {
+		t.Errorf("expected 5 calls, got %d", calls)
+	}
+}
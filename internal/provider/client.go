@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// idempotencyKeyHeader is the request header the HashiCups API uses to
+// deduplicate create requests that are sent more than once.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// apiClient wraps the HashiCups client and adds the API endpoints that the
+// hashicups-client-go library does not cover yet. It is what the provider
+// hands to resources and data sources during Configure.
+type apiClient struct {
+	*hashicups.Client
+
+	// defaultTags are the provider's default_tags, merged into the tags of
+	// every taggable resource.
+	defaultTags map[string]string
+}
+
+// apiError is returned when the HashiCups API responds with a non-2xx status.
+// Its message matches the errors returned by the hashicups-client-go library.
+type apiError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// isNotFound reports whether err is a 404 Not Found response from the
+// HashiCups API, returned either by apiClient.do or by the hashicups-client-go
+// library, whose errors only carry the status in their message.
+func isNotFound(err error) bool {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+
+	return err != nil && strings.HasPrefix(err.Error(), fmt.Sprintf("status: %d,", http.StatusNotFound))
+}
+
+// isPreconditionFailed reports whether err is a 412 Precondition Failed
+// response, which the HashiCups API returns when an If-Match header no longer
+// matches the current version of the object.
+func isPreconditionFailed(err error) bool {
+	var apiErr *apiError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPreconditionFailed
+}
+
+// withToken returns a copy of the client that authenticates its requests
+// with token instead of the client's own token.
+func (c *apiClient) withToken(token string) *apiClient {
+	client := *c.Client
+	client.Token = token
+
+	return &apiClient{Client: &client, defaultTags: c.defaultTags}
+}
+
+// do sends a JSON request to the HashiCups API, authenticated with the
+// client's token. When in is not nil it is encoded as the request body, and
+// when out is not nil the response body is decoded into it.
+func (c *apiClient) do(ctx context.Context, method, path string, in, out any) error {
+	_, err := c.send(ctx, method, path, nil, in, out)
+
+	return err
+}
+
+// doConditional is like do, but sends ifMatch as the If-Match header when it
+// is not empty, and returns the ETag of the response. It is used by
+// resources that keep the ETag in private state, see etagPrivateKey.
+func (c *apiClient) doConditional(ctx context.Context, method, path, ifMatch string, in, out any) (string, error) {
+	header, err := c.send(ctx, method, path, ifMatchHeader(ifMatch), in, out)
+	if err != nil {
+		return "", err
+	}
+
+	return header.Get("ETag"), nil
+}
+
+// send is like do, but additionally sets the given request headers and
+// returns the response headers, for endpoints that exchange metadata such as
+// ETags outside of the body.
+func (c *apiClient) send(ctx context.Context, method, path string, header http.Header, in, out any) (http.Header, error) {
+	var body []byte
+	var contentType string
+	if in != nil {
+		rb, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body, contentType = rb, "application/json"
+	}
+
+	return c.sendBody(ctx, method, path, header, contentType, body, out)
+}
+
+// sendBody is like send, but sends body as is with the given content type,
+// for endpoints that take a non-JSON request body such as an image. The
+// request has no body when body is nil.
+func (c *apiClient) sendBody(ctx context.Context, method, path string, header http.Header, contentType string, body []byte, out any) (http.Header, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.HostURL+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Authorization", c.Token)
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	rb, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, &apiError{StatusCode: res.StatusCode, Body: string(rb)}
+	}
+
+	if out == nil || len(rb) == 0 {
+		return res.Header, nil
+	}
+
+	return res.Header, json.Unmarshal(rb, out)
+}
+
+// ifMatchHeader returns the request headers for a conditional request on
+// etag, or nil when etag is empty.
+func ifMatchHeader(etag string) http.Header {
+	if etag == "" {
+		return nil
+	}
+
+	return http.Header{"If-Match": []string{etag}}
+}
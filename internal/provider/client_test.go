@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {
+			err:      nil,
+			expected: false,
+		},
+		"api-error-404": {
+			err:      &apiError{StatusCode: 404, Body: "not found"},
+			expected: true,
+		},
+		"api-error-500": {
+			err:      &apiError{StatusCode: 500, Body: "oops"},
+			expected: false,
+		},
+		"wrapped-api-error-404": {
+			err:      fmt.Errorf("reading cafe: %w", &apiError{StatusCode: 404}),
+			expected: true,
+		},
+		"library-error-404": {
+			err:      fmt.Errorf("status: %d, body: %s", 404, "not found"),
+			expected: true,
+		},
+		"library-error-4040": {
+			err:      fmt.Errorf("status: %d, body: %s", 4040, ""),
+			expected: false,
+		},
+		"other": {
+			err:      errors.New("connection refused"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isNotFound(testCase.err); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &coffeeIngredientResource{}
+	_ resource.ResourceWithConfigure   = &coffeeIngredientResource{}
+	_ resource.ResourceWithImportState = &coffeeIngredientResource{}
+)
+
+// NewCoffeeIngredientResource is a helper function to simplify the provider implementation.
+func NewCoffeeIngredientResource() resource.Resource {
+	return &coffeeIngredientResource{}
+}
+
+// coffeeIngredientResource is the resource implementation.
+type coffeeIngredientResource struct {
+	client *apiClient
+}
+
+// coffeeIngredientResourceModel maps the resource schema data.
+type coffeeIngredientResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	CoffeeID     types.String `tfsdk:"coffee_id"`
+	IngredientID types.String `tfsdk:"ingredient_id"`
+	Quantity     types.Int64  `tfsdk:"quantity"`
+	Unit         types.String `tfsdk:"unit"`
+}
+
+// apiCoffeeIngredient is the request body that adds an ingredient to the
+// recipe of a coffee, as sent by hashicups.Client.CreateCoffeeIngredient.
+// The API responds with the ingredient as a hashicups.Ingredient.
+type apiCoffeeIngredient struct {
+	CoffeeID     int    `json:"coffee_id"`
+	IngredientID int    `json:"ingredient_id"`
+	Quantity     int    `json:"quantity"`
+	Unit         string `json:"unit"`
+}
+
+// Metadata returns the resource type name.
+func (r *coffeeIngredientResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coffee_ingredient"
+}
+
+// Schema defines the schema for the resource.
+func (r *coffeeIngredientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"coffee_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ingredient_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quantity": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *coffeeIngredientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan coffeeIngredientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingredient hashicups.Ingredient
+	err := r.client.do(ctx, http.MethodPost, "/coffees/"+plan.CoffeeID.ValueString()+"/ingredients", body, &ingredient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating coffee ingredient",
+			"Could not create coffee ingredient, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(coffeeIngredientID(plan.CoffeeID.ValueString(), plan.IngredientID.ValueString()))
+	plan.fromAPI(ingredient)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information. The API only lists the ingredients of a
+// coffee, so the ingredient is looked up in the list.
+func (r *coffeeIngredientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coffeeIngredientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingredients []hashicups.Ingredient
+	err := r.client.do(ctx, http.MethodGet, "/coffees/"+state.CoffeeID.ValueString()+"/ingredients", nil, &ingredients)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Coffee Ingredient",
+			"Could not read HashiCups coffee ingredient ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, ingredient := range ingredients {
+		if strconv.Itoa(ingredient.ID) == state.IngredientID.ValueString() {
+			state.fromAPI(ingredient)
+
+			diags = resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	tflog.Warn(ctx, "HashiCups coffee ingredient not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *coffeeIngredientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coffeeIngredientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingredient hashicups.Ingredient
+	err := r.client.do(ctx, http.MethodPut, plan.apiPath(), body, &ingredient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Coffee Ingredient",
+			"Could not update coffee ingredient, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(ingredient)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *coffeeIngredientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coffeeIngredientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, state.apiPath(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Coffee Ingredient Already Deleted",
+			"The coffee ingredient was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Coffee Ingredient",
+			"Could not delete coffee ingredient, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *coffeeIngredientResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an ingredient of a coffee by its composite ID,
+// "coffee/<coffee_id>/ingredient/<ingredient_id>".
+func (r *coffeeIngredientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	coffeeID, ingredientID, err := parseCoffeeIngredientID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Coffee Ingredient Import ID",
+			"Could not parse the import ID: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("coffee_id"), coffeeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ingredient_id"), ingredientID)...)
+}
+
+// apiPath returns the API path of the ingredient of the coffee.
+func (m coffeeIngredientResourceModel) apiPath() string {
+	return "/coffees/" + m.CoffeeID.ValueString() + "/ingredients/" + m.IngredientID.ValueString()
+}
+
+// toAPI builds the API request body from the resource model.
+func (m coffeeIngredientResourceModel) toAPI() (apiCoffeeIngredient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := apiCoffeeIngredient{
+		CoffeeID:     parseObjectID(path.Root("coffee_id"), m.CoffeeID.ValueString(), &diags),
+		IngredientID: parseObjectID(path.Root("ingredient_id"), m.IngredientID.ValueString(), &diags),
+		Quantity:     int(m.Quantity.ValueInt64()),
+		Unit:         m.Unit.ValueString(),
+	}
+
+	return body, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *coffeeIngredientResourceModel) fromAPI(ingredient hashicups.Ingredient) {
+	m.Quantity = types.Int64Value(int64(ingredient.Quantity))
+	m.Unit = types.StringValue(ingredient.Unit)
+}
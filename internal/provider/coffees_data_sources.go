@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/datasourcefilter"
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -23,12 +28,19 @@ func NewCoffeesDataSource() datasource.DataSource {
 
 // coffeesDataSource is the data source implementation.
 type coffeesDataSource struct {
-	client *hashicups.Client
+	client *apiClient
 }
 
 // coffeesDataSourceModel maps the data source schema data.
 type coffeesDataSourceModel struct {
-	Coffees []coffeesModel `tfsdk:"coffees"`
+	Name          types.String             `tfsdk:"name"`
+	MaxItems      types.Int64              `tfsdk:"max_items"`
+	PageSize      types.Int64              `tfsdk:"page_size"`
+	SortBy        types.String             `tfsdk:"sort_by"`
+	SortOrder     types.String             `tfsdk:"sort_order"`
+	Filters       []datasourcefilter.Model `tfsdk:"filter"`
+	Coffees       []coffeesModel           `tfsdk:"coffees"`
+	CoffeesByName map[string]coffeesModel  `tfsdk:"coffees_by_name"`
 }
 
 // coffeesModel maps coffees schema data.
@@ -37,7 +49,7 @@ type coffeesModel struct {
 	Name        types.String              `tfsdk:"name"`
 	Teaser      types.String              `tfsdk:"teaser"`
 	Description types.String              `tfsdk:"description"`
-	Price       types.Float64             `tfsdk:"price"`
+	Price       decimal.Value             `tfsdk:"price"`
 	Image       types.String              `tfsdk:"image"`
 	Ingredients []coffeesIngredientsModel `tfsdk:"ingredients"`
 }
@@ -47,6 +59,19 @@ type coffeesIngredientsModel struct {
 	ID types.Int64 `tfsdk:"id"`
 }
 
+// coffeesSortFields are the fields the coffees can be sorted by.
+var coffeesSortFields = sortFields[hashicups.Coffee]{
+	"id": func(a, b hashicups.Coffee) int {
+		return cmp.Compare(a.ID, b.ID)
+	},
+	"name": func(a, b hashicups.Coffee) int {
+		return cmp.Compare(a.Name, b.Name)
+	},
+	"price": func(a, b hashicups.Coffee) int {
+		return cmp.Compare(a.Price, b.Price)
+	},
+}
+
 // Metadata returns the data source type name.
 func (d *coffeesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_coffees"
@@ -54,44 +79,68 @@ func (d *coffeesDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 // Schema defines the schema for the data source.
 func (d *coffeesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	coffeeAttributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"teaser": schema.StringAttribute{
+			Computed: true,
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"price": schema.StringAttribute{
+			CustomType: decimal.Type{},
+			Computed:   true,
+		},
+		"image": schema.StringAttribute{
+			Computed: true,
+		},
+		"ingredients": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			// name limits coffees to those with exactly this name, so that
+			// a coffee can be referred to by name rather than by its ID.
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"max_items":  maxItemsAttribute(),
+			"page_size":  pageSizeAttribute(),
+			"sort_by":    sortByAttribute(coffeesSortFields),
+			"sort_order": sortOrderAttribute(),
 			"coffees": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.Int64Attribute{
-							Computed: true,
-						},
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"teaser": schema.StringAttribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"price": schema.Float64Attribute{
-							Computed: true,
-						},
-						"image": schema.StringAttribute{
-							Computed: true,
-						},
-						"ingredients": schema.ListNestedAttribute{
-							Computed: true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"id": schema.Int64Attribute{
-										Computed: true,
-									},
-								},
-							},
-						},
-					},
+					Attributes: coffeeAttributes,
+				},
+			},
+			// coffees_by_name holds the same coffees keyed by name, so that they
+			// can be indexed directly. The first of several coffees with the
+			// same name is kept.
+			"coffees_by_name": schema.MapNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: coffeeAttributes,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": datasourcefilter.Block("id", "name", "teaser"),
+		},
 	}
 }
 
@@ -100,8 +149,23 @@ func (d *coffeesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 // READ
 func (d *coffeesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state coffeesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	coffees, err := listSorted(ctx, d.client, "/coffees", nil, newListOptions(state.MaxItems, state.PageSize), coffeesSortFields, state.SortBy, state.SortOrder, func(coffee hashicups.Coffee) bool {
+		if !state.Name.IsNull() && coffee.Name != state.Name.ValueString() {
+			return false
+		}
 
-	coffees, err := d.client.GetCoffees()
+		return datasourcefilter.Match(state.Filters, map[string]string{
+			"id":     strconv.Itoa(coffee.ID),
+			"name":   coffee.Name,
+			"teaser": coffee.Teaser,
+		})
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read HashiCups Coffees",
@@ -111,13 +175,14 @@ func (d *coffeesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Map response body to model
+	state.Coffees = []coffeesModel{}
 	for _, coffee := range coffees {
 		coffeeState := coffeesModel{
 			ID:          types.Int64Value(int64(coffee.ID)),
 			Name:        types.StringValue(coffee.Name),
 			Teaser:      types.StringValue(coffee.Teaser),
 			Description: types.StringValue(coffee.Description),
-			Price:       types.Float64Value(coffee.Price),
+			Price:       decimal.NewFloat64Value(coffee.Price),
 			Image:       types.StringValue(coffee.Image),
 		}
 
@@ -130,8 +195,19 @@ func (d *coffeesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		state.Coffees = append(state.Coffees, coffeeState)
 	}
 
+	var duplicates []string
+	state.CoffeesByName, duplicates = indexByName(state.Coffees, func(coffee coffeesModel) string {
+		return coffee.Name.ValueString()
+	})
+	if len(duplicates) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Duplicate Coffee Names",
+			fmt.Sprintf("Several coffees share the names %q. Only the first coffee with each name is in coffees_by_name.", duplicates),
+		)
+	}
+
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -147,11 +223,11 @@ func (d *coffeesDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*hashicups.Client)
+	client, ok := req.ProviderData.(*apiClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
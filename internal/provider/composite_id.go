@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// cafeCompositeID identifies an object that belongs to a cafe, such as
+// "cafe/123/menu/7". ChildType and ChildID are empty when the ID identifies
+// the cafe itself, as in "cafe/123".
+type cafeCompositeID struct {
+	CafeID    string
+	ChildType string
+	ChildID   string
+}
+
+// String returns the composite ID in its "cafe/<cafe_id>[/<type>/<id>]" form.
+func (id cafeCompositeID) String() string {
+	if id.ChildType == "" {
+		return "cafe/" + id.CafeID
+	}
+
+	return "cafe/" + id.CafeID + "/" + id.ChildType + "/" + id.ChildID
+}
+
+// parseCafeID parses a composite ID of the form "cafe/<cafe_id>" or
+// "cafe/<cafe_id>/<type>/<id>". Every component must be non-empty.
+func parseCafeID(s string) (cafeCompositeID, error) {
+	parts := strings.Split(s, "/")
+	if (len(parts) != 2 && len(parts) != 4) || parts[0] != "cafe" {
+		return cafeCompositeID{}, fmt.Errorf("expected an ID of the form cafe/<cafe_id> or cafe/<cafe_id>/<type>/<id>, got: %q", s)
+	}
+
+	for _, part := range parts[1:] {
+		if part == "" {
+			return cafeCompositeID{}, fmt.Errorf("ID %q has an empty component", s)
+		}
+	}
+
+	id := cafeCompositeID{CafeID: parts[1]}
+	if len(parts) == 4 {
+		id.ChildType = parts[2]
+		id.ChildID = parts[3]
+	}
+
+	return id, nil
+}
+
+// coffeeIngredientID returns the composite ID of an ingredient of a coffee.
+func coffeeIngredientID(coffeeID, ingredientID string) string {
+	return "coffee/" + coffeeID + "/ingredient/" + ingredientID
+}
+
+// parseCoffeeIngredientID parses a composite ID of the form
+// "coffee/<coffee_id>/ingredient/<ingredient_id>" into the coffee and
+// ingredient IDs.
+func parseCoffeeIngredientID(s string) (string, string, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 4 || parts[0] != "coffee" || parts[2] != "ingredient" || parts[1] == "" || parts[3] == "" {
+		return "", "", fmt.Errorf("expected an ID of the form coffee/<coffee_id>/ingredient/<ingredient_id>, got: %q", s)
+	}
+
+	return parts[1], parts[3], nil
+}
+
+// parseObjectID converts the ID of a HashiCups object, which is kept in state
+// as a string, to the integer the API uses to refer to the object in request
+// bodies. It adds an error diagnostic for the attribute at p when id is not
+// an integer.
+func parseObjectID(p path.Path, id string, diags *diag.Diagnostics) int {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid HashiCups Object ID",
+			fmt.Sprintf("Expected %s to be the numeric ID of a HashiCups object, got: %q", p, id),
+		)
+		return 0
+	}
+
+	return n
+}
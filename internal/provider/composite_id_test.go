@@ -0,0 +1,125 @@
+package provider
+
+import "testing"
+
+func TestParseCafeID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id          string
+		expected    cafeCompositeID
+		expectError bool
+	}{
+		"cafe": {
+			id:       "cafe/123",
+			expected: cafeCompositeID{CafeID: "123"},
+		},
+		"child": {
+			id:       "cafe/123/menu/7",
+			expected: cafeCompositeID{CafeID: "123", ChildType: "menu", ChildID: "7"},
+		},
+		"bare-id": {
+			id:          "123",
+			expectError: true,
+		},
+		"wrong-prefix": {
+			id:          "coffee/123",
+			expectError: true,
+		},
+		"missing-child-id": {
+			id:          "cafe/123/menu",
+			expectError: true,
+		},
+		"empty-component": {
+			id:          "cafe/123/menu/",
+			expectError: true,
+		},
+		"too-many-components": {
+			id:          "cafe/123/menu/7/coffee/1",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseCafeID(testCase.id)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got: %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %+v, got %+v", testCase.expected, got)
+			}
+
+			if got.String() != testCase.id {
+				t.Errorf("expected String() %q, got %q", testCase.id, got.String())
+			}
+		})
+	}
+}
+
+func TestParseCoffeeIngredientID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id                   string
+		expectedCoffeeID     string
+		expectedIngredientID string
+		expectError          bool
+	}{
+		"valid": {
+			id:                   "coffee/1/ingredient/2",
+			expectedCoffeeID:     "1",
+			expectedIngredientID: "2",
+		},
+		"cafe-id": {
+			id:          "cafe/1/ingredient/2",
+			expectError: true,
+		},
+		"wrong-child-type": {
+			id:          "coffee/1/menu/2",
+			expectError: true,
+		},
+		"missing-ingredient-id": {
+			id:          "coffee/1/ingredient/",
+			expectError: true,
+		},
+		"bare-ids": {
+			id:          "1/2",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			coffeeID, ingredientID, err := parseCoffeeIngredientID(testCase.id)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got: %q, %q", coffeeID, ingredientID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if coffeeID != testCase.expectedCoffeeID || ingredientID != testCase.expectedIngredientID {
+				t.Errorf("expected %q, %q, got %q, %q", testCase.expectedCoffeeID, testCase.expectedIngredientID, coffeeID, ingredientID)
+			}
+
+			if got := coffeeIngredientID(coffeeID, ingredientID); got != testCase.id {
+				t.Errorf("expected coffeeIngredientID %q, got %q", testCase.id, got)
+			}
+		})
+	}
+}
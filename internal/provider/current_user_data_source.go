@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &currentUserDataSource{}
+	_ datasource.DataSourceWithConfigure = &currentUserDataSource{}
+)
+
+// NewCurrentUserDataSource is a helper function to simplify the provider implementation.
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &currentUserDataSource{}
+}
+
+// currentUserDataSource is the data source implementation.
+type currentUserDataSource struct {
+	client *apiClient
+}
+
+// currentUserDataSourceModel maps the data source schema data.
+type currentUserDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	Email       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+// apiCurrentUser is the API representation of the user the provider is
+// authenticated as, along with the permissions granted to it by its role.
+type apiCurrentUser struct {
+	apiUser
+	Permissions []string `json:"permissions"`
+}
+
+// Metadata returns the data source type name.
+func (d *currentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *currentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+			"email": schema.StringAttribute{
+				Computed: true,
+			},
+			"role": schema.StringAttribute{
+				Computed: true,
+			},
+			"permissions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the user the provider's
+// credentials belong to.
+func (d *currentUserDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var user apiCurrentUser
+	err := d.client.do(ctx, http.MethodGet, "/users/me", nil, &user)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Current User",
+			err.Error(),
+		)
+		return
+	}
+
+	// A user without permissions has an empty set rather than a null one.
+	permissions := user.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	permissionsValue, diags := types.SetValueFrom(ctx, types.StringType, permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state := currentUserDataSourceModel{
+		ID:          types.StringValue(strconv.Itoa(user.ID)),
+		Username:    types.StringValue(user.Username),
+		Email:       types.StringValue(user.Email),
+		Role:        stringValueOrNull(user.Role),
+		Permissions: permissionsValue,
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *currentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
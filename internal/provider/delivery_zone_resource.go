@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/validators/configvalidator"
+	"terraform-provider-inpyu-ossca/internal/validators/float64validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &deliveryZoneResource{}
+	_ resource.ResourceWithConfigure        = &deliveryZoneResource{}
+	_ resource.ResourceWithConfigValidators = &deliveryZoneResource{}
+	_ resource.ResourceWithValidateConfig   = &deliveryZoneResource{}
+	_ resource.ResourceWithImportState      = &deliveryZoneResource{}
+)
+
+// NewDeliveryZoneResource is a helper function to simplify the provider implementation.
+func NewDeliveryZoneResource() resource.Resource {
+	return &deliveryZoneResource{}
+}
+
+// deliveryZoneResource is the resource implementation.
+type deliveryZoneResource struct {
+	client *apiClient
+}
+
+// deliveryZoneResourceModel maps the resource schema data.
+type deliveryZoneResourceModel struct {
+	ID           types.String         `tfsdk:"id"`
+	CafeID       types.String         `tfsdk:"cafe_id"`
+	Polygon      []locationModel      `tfsdk:"polygon"`
+	Radius       *deliveryRadiusModel `tfsdk:"radius"`
+	DeliveryFee  decimal.Value        `tfsdk:"delivery_fee"`
+	MinimumOrder decimal.Value        `tfsdk:"minimum_order"`
+}
+
+// deliveryRadiusModel maps a circular delivery zone.
+type deliveryRadiusModel struct {
+	Latitude  types.Float64 `tfsdk:"latitude"`
+	Longitude types.Float64 `tfsdk:"longitude"`
+	Meters    types.Float64 `tfsdk:"meters"`
+}
+
+// apiDeliveryZone is the API representation of a cafe's delivery zone, which
+// is either a polygon or a circle.
+type apiDeliveryZone struct {
+	ID           int                `json:"id,omitempty"`
+	CafeID       int                `json:"cafe_id"`
+	Polygon      []apiLocation      `json:"polygon,omitempty"`
+	Radius       *apiDeliveryRadius `json:"radius,omitempty"`
+	DeliveryFee  json.Number        `json:"delivery_fee"`
+	MinimumOrder *json.Number       `json:"minimum_order,omitempty"`
+}
+
+// apiDeliveryRadius is the API representation of a circular delivery zone.
+type apiDeliveryRadius struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Meters    float64 `json:"meters"`
+}
+
+// Metadata returns the resource type name.
+func (r *deliveryZoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delivery_zone"
+}
+
+// Schema defines the schema for the resource.
+func (r *deliveryZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	coordinateAttributes := map[string]schema.Attribute{
+		"latitude": schema.Float64Attribute{
+			Required: true,
+			Validators: []validator.Float64{
+				float64validator.Between(-90, 90),
+			},
+		},
+		"longitude": schema.Float64Attribute{
+			Required: true,
+			Validators: []validator.Float64{
+				float64validator.Between(-180, 180),
+			},
+		},
+	}
+
+	radiusAttributes := map[string]schema.Attribute{
+		"meters": schema.Float64Attribute{
+			Required: true,
+		},
+	}
+	maps.Copy(radiusAttributes, coordinateAttributes)
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// polygon lists the vertices of the zone, which is closed
+			// automatically, so the first vertex is not repeated.
+			"polygon": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: coordinateAttributes,
+				},
+			},
+			"radius": schema.SingleNestedAttribute{
+				Optional:   true,
+				Attributes: radiusAttributes,
+			},
+			"delivery_fee": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Required:   true,
+			},
+			"minimum_order": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Optional:   true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires the zone to be either a polygon or a circle.
+func (r *deliveryZoneResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		configvalidator.ExactlyOneOf(
+			path.MatchRoot("polygon"),
+			path.MatchRoot("radius"),
+		),
+	}
+}
+
+// ValidateConfig checks the geometry of the zone, and that the fee and the
+// minimum order are not negative.
+func (r *deliveryZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var polygon types.List
+	var meters types.Float64
+	var deliveryFee, minimumOrder decimal.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("polygon"), &polygon)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("radius").AtName("meters"), &meters)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("delivery_fee"), &deliveryFee)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("minimum_order"), &minimumOrder)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !polygon.IsNull() && !polygon.IsUnknown() {
+		var locations []locationModel
+		resp.Diagnostics.Append(polygon.ElementsAs(ctx, &locations, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		points, known := geoPoints(locations)
+		if known {
+			if err := validatePolygon(points); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("polygon"),
+					"Invalid Delivery Zone Polygon",
+					"The delivery zone must be a simple polygon: "+err.Error(),
+				)
+			}
+		}
+	}
+
+	if !meters.IsNull() && !meters.IsUnknown() && meters.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("radius").AtName("meters"),
+			"Invalid Delivery Zone Radius",
+			fmt.Sprintf("The radius must be greater than 0, got: %g", meters.ValueFloat64()),
+		)
+	}
+
+	for name, amount := range map[string]decimal.Value{"delivery_fee": deliveryFee, "minimum_order": minimumOrder} {
+		if v, ok := knownDecimal(amount); ok && v.Sign() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Delivery Zone Amount",
+				name+" must not be negative, got: "+amount.ValueString(),
+			)
+		}
+	}
+}
+
+// geoPoints converts locations to points, and reports whether all of their
+// coordinates are known.
+func geoPoints(locations []locationModel) ([]geoPoint, bool) {
+	points := make([]geoPoint, 0, len(locations))
+	for _, location := range locations {
+		if location.Latitude.IsUnknown() || location.Longitude.IsUnknown() {
+			return nil, false
+		}
+
+		points = append(points, geoPoint{
+			Latitude:  location.Latitude.ValueFloat64(),
+			Longitude: location.Longitude.ValueFloat64(),
+		})
+	}
+
+	return points, true
+}
+
+// Create a new resource.
+func (r *deliveryZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deliveryZoneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiDeliveryZone
+	err := r.client.do(ctx, http.MethodPost, "/delivery-zones", zone, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating delivery zone",
+			"Could not create delivery zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *deliveryZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deliveryZoneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var zone apiDeliveryZone
+	err := r.client.do(ctx, http.MethodGet, "/delivery-zones/"+state.ID.ValueString(), nil, &zone)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups delivery zone not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Delivery Zone",
+			"Could not read HashiCups delivery zone ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(zone)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *deliveryZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan deliveryZoneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiDeliveryZone
+	err := r.client.do(ctx, http.MethodPut, "/delivery-zones/"+plan.ID.ValueString(), zone, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Delivery Zone",
+			"Could not update delivery zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *deliveryZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deliveryZoneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/delivery-zones/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Delivery Zone Already Deleted",
+			"The delivery zone was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Delivery Zone",
+			"Could not delete delivery zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deliveryZoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing delivery zone by its ID.
+func (r *deliveryZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m deliveryZoneResourceModel) toAPI() (apiDeliveryZone, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	zone := apiDeliveryZone{
+		CafeID:      parseObjectID(path.Root("cafe_id"), m.CafeID.ValueString(), &diags),
+		DeliveryFee: json.Number(m.DeliveryFee.ValueString()),
+	}
+	for _, location := range m.Polygon {
+		zone.Polygon = append(zone.Polygon, apiLocation{
+			Latitude:  location.Latitude.ValueFloat64(),
+			Longitude: location.Longitude.ValueFloat64(),
+		})
+	}
+	if m.Radius != nil {
+		zone.Radius = &apiDeliveryRadius{
+			Latitude:  m.Radius.Latitude.ValueFloat64(),
+			Longitude: m.Radius.Longitude.ValueFloat64(),
+			Meters:    m.Radius.Meters.ValueFloat64(),
+		}
+	}
+	if !m.MinimumOrder.IsNull() {
+		minimumOrder := json.Number(m.MinimumOrder.ValueString())
+		zone.MinimumOrder = &minimumOrder
+	}
+
+	return zone, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *deliveryZoneResourceModel) fromAPI(zone apiDeliveryZone) {
+	m.ID = types.StringValue(strconv.Itoa(zone.ID))
+	m.CafeID = types.StringValue(strconv.Itoa(zone.CafeID))
+
+	m.Polygon = nil
+	for _, location := range zone.Polygon {
+		m.Polygon = append(m.Polygon, locationModel{
+			Latitude:  types.Float64Value(location.Latitude),
+			Longitude: types.Float64Value(location.Longitude),
+		})
+	}
+
+	m.Radius = nil
+	if zone.Radius != nil {
+		m.Radius = &deliveryRadiusModel{
+			Latitude:  types.Float64Value(zone.Radius.Latitude),
+			Longitude: types.Float64Value(zone.Radius.Longitude),
+			Meters:    types.Float64Value(zone.Radius.Meters),
+		}
+	}
+
+	m.DeliveryFee = decimal.NewValue(zone.DeliveryFee.String())
+	m.MinimumOrder = decimal.NewNull()
+	if zone.MinimumOrder != nil {
+		m.MinimumOrder = decimal.NewValue(zone.MinimumOrder.String())
+	}
+}
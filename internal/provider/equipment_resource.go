@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &equipmentResource{}
+	_ resource.ResourceWithConfigure   = &equipmentResource{}
+	_ resource.ResourceWithImportState = &equipmentResource{}
+)
+
+// NewEquipmentResource is a helper function to simplify the provider implementation.
+func NewEquipmentResource() resource.Resource {
+	return &equipmentResource{}
+}
+
+// equipmentResource is the resource implementation.
+type equipmentResource struct {
+	client *apiClient
+}
+
+// equipmentResourceModel maps the resource schema data.
+type equipmentResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	CafeID                  types.String `tfsdk:"cafe_id"`
+	Name                    types.String `tfsdk:"name"`
+	Type                    types.String `tfsdk:"type"`
+	SerialNumber            types.String `tfsdk:"serial_number"`
+	PurchaseDate            types.String `tfsdk:"purchase_date"`
+	MaintenanceIntervalDays types.Int64  `tfsdk:"maintenance_interval_days"`
+}
+
+// apiAsset is the API representation of a piece of equipment at a cafe,
+// which the API calls an asset. PurchaseDate is in YYYY-MM-DD format, and
+// MaintenanceIntervalDays is nil for equipment without scheduled
+// maintenance.
+type apiAsset struct {
+	ID                      int    `json:"id,omitempty"`
+	CafeID                  int    `json:"cafe_id"`
+	Name                    string `json:"name"`
+	Type                    string `json:"type"`
+	SerialNumber            string `json:"serial_number,omitempty"`
+	PurchaseDate            string `json:"purchase_date,omitempty"`
+	MaintenanceIntervalDays *int64 `json:"maintenance_interval_days,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *equipmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_equipment"
+}
+
+// Schema defines the schema for the resource.
+func (r *equipmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			// type is the kind of equipment, such as "espresso_machine" or
+			// "grinder".
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			// A different serial number is a different machine, so the
+			// equipment is replaced rather than updated.
+			"serial_number": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"purchase_date": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.Date(),
+				},
+			},
+			"maintenance_interval_days": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *equipmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan equipmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiAsset
+	err := r.client.do(ctx, http.MethodPost, "/assets", asset, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating equipment",
+			"Could not create equipment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *equipmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state equipmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var asset apiAsset
+	err := r.client.do(ctx, http.MethodGet, "/assets/"+state.ID.ValueString(), nil, &asset)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups equipment not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Equipment",
+			"Could not read HashiCups equipment ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(asset)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *equipmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan equipmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiAsset
+	err := r.client.do(ctx, http.MethodPut, "/assets/"+plan.ID.ValueString(), asset, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Equipment",
+			"Could not update equipment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *equipmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state equipmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/assets/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Equipment Already Deleted",
+			"The equipment was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Equipment",
+			"Could not delete equipment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *equipmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing piece of equipment by its ID.
+func (r *equipmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m equipmentResourceModel) toAPI() (apiAsset, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	asset := apiAsset{
+		CafeID:                  parseObjectID(path.Root("cafe_id"), m.CafeID.ValueString(), &diags),
+		Name:                    m.Name.ValueString(),
+		Type:                    m.Type.ValueString(),
+		SerialNumber:            m.SerialNumber.ValueString(),
+		PurchaseDate:            m.PurchaseDate.ValueString(),
+		MaintenanceIntervalDays: m.MaintenanceIntervalDays.ValueInt64Pointer(),
+	}
+
+	return asset, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *equipmentResourceModel) fromAPI(asset apiAsset) {
+	m.ID = types.StringValue(strconv.Itoa(asset.ID))
+	m.CafeID = types.StringValue(strconv.Itoa(asset.CafeID))
+	m.Name = types.StringValue(asset.Name)
+	m.Type = types.StringValue(asset.Type)
+	m.SerialNumber = stringValueOrNull(asset.SerialNumber)
+	m.PurchaseDate = stringValueOrNull(asset.PurchaseDate)
+	m.MaintenanceIntervalDays = types.Int64PointerValue(asset.MaintenanceIntervalDays)
+}
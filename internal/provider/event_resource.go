@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"terraform-provider-inpyu-ossca/internal/types/urltype"
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &eventResource{}
+	_ resource.ResourceWithConfigure      = &eventResource{}
+	_ resource.ResourceWithValidateConfig = &eventResource{}
+	_ resource.ResourceWithModifyPlan     = &eventResource{}
+	_ resource.ResourceWithImportState    = &eventResource{}
+)
+
+// NewEventResource is a helper function to simplify the provider implementation.
+func NewEventResource() resource.Resource {
+	return &eventResource{}
+}
+
+// eventResource is the resource implementation.
+type eventResource struct {
+	client *apiClient
+}
+
+// eventResourceModel maps the resource schema data.
+type eventResourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	CafeID      types.String  `tfsdk:"cafe_id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	StartsAt    types.String  `tfsdk:"starts_at"`
+	EndsAt      types.String  `tfsdk:"ends_at"`
+	Capacity    types.Int64   `tfsdk:"capacity"`
+	TicketURL   urltype.Value `tfsdk:"ticket_url"`
+}
+
+// apiEvent is the API representation of an event at a cafe, such as a
+// tasting or a launch.
+type apiEvent struct {
+	ID          int    `json:"id,omitempty"`
+	CafeID      int    `json:"cafe_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	StartsAt    string `json:"starts_at"`
+	EndsAt      string `json:"ends_at"`
+	Capacity    *int64 `json:"capacity,omitempty"`
+	TicketURL   string `json:"ticket_url,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *eventResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event"
+}
+
+// Schema defines the schema for the resource.
+func (r *eventResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			// The event must take place while the cafe is open, if the cafe
+			// has opening hours.
+			"starts_at": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			// The number of guests is unlimited when capacity is null.
+			"capacity": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ticket_url": schema.StringAttribute{
+				CustomType: urltype.Type{},
+				Optional:   true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the event ends after it starts.
+func (r *eventResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var startsAt, endsAt types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("starts_at"), &startsAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ends_at"), &endsAt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeWindow(startsAt, endsAt, path.Root("ends_at"), "Invalid Event Schedule", &resp.Diagnostics)
+}
+
+// ModifyPlan fails the plan when the cafe has opening hours and the event
+// does not fall inside them, instead of the event being scheduled while the
+// cafe is closed. It is skipped while the provider is not configured yet or
+// the cafe or schedule is unknown, such as for a cafe created in the same
+// apply.
+func (r *eventResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var cafeID, startsAt, endsAt types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cafe_id"), &cafeID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("starts_at"), &startsAt)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ends_at"), &endsAt)...)
+	if resp.Diagnostics.HasError() || cafeID.IsUnknown() || startsAt.IsUnknown() || endsAt.IsUnknown() {
+		return
+	}
+
+	start, startErr := time.Parse(time.RFC3339, startsAt.ValueString())
+	end, endErr := time.Parse(time.RFC3339, endsAt.ValueString())
+	if startErr != nil || endErr != nil {
+		return
+	}
+
+	cafes, _, err := r.client.getCafe(ctx, cafeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Cafe",
+			"Could not check the event schedule against the opening hours of cafe ID "+cafeID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if len(cafes) == 0 || cafes[0].OpeningHours == nil || len(*cafes[0].OpeningHours) == 0 {
+		return
+	}
+
+	if !withinOpeningHours(*cafes[0].OpeningHours, start, end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("starts_at"),
+			"Event Outside Opening Hours",
+			fmt.Sprintf("The event from %s to %s does not fall inside the opening hours of cafe ID %s.", startsAt.ValueString(), endsAt.ValueString(), cafeID.ValueString()),
+		)
+	}
+}
+
+// Create a new resource.
+func (r *eventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan eventResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiEvent
+	err := r.client.do(ctx, http.MethodPost, "/events", event, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating event",
+			"Could not create event, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *eventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eventResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var event apiEvent
+	err := r.client.do(ctx, http.MethodGet, "/events/"+state.ID.ValueString(), nil, &event)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups event not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Event",
+			"Could not read HashiCups event ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(event)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *eventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan eventResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiEvent
+	err := r.client.do(ctx, http.MethodPut, "/events/"+plan.ID.ValueString(), event, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Event",
+			"Could not update event, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *eventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state eventResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/events/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Event Already Deleted",
+			"The event was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Event",
+			"Could not delete event, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *eventResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing event by its ID.
+func (r *eventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m eventResourceModel) toAPI() (apiEvent, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	event := apiEvent{
+		CafeID:      parseObjectID(path.Root("cafe_id"), m.CafeID.ValueString(), &diags),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		StartsAt:    m.StartsAt.ValueString(),
+		EndsAt:      m.EndsAt.ValueString(),
+		Capacity:    m.Capacity.ValueInt64Pointer(),
+		TicketURL:   m.TicketURL.ValueString(),
+	}
+
+	return event, diags
+}
+
+// fromAPI maps an API response body onto the resource model. Unset optional
+// attributes are kept null.
+func (m *eventResourceModel) fromAPI(event apiEvent) {
+	m.ID = types.StringValue(strconv.Itoa(event.ID))
+	m.CafeID = types.StringValue(strconv.Itoa(event.CafeID))
+	m.Name = types.StringValue(event.Name)
+	m.Description = stringValueOrNull(event.Description)
+	m.StartsAt = types.StringValue(event.StartsAt)
+	m.EndsAt = types.StringValue(event.EndsAt)
+	m.Capacity = types.Int64PointerValue(event.Capacity)
+	m.TicketURL = urltype.NewNull()
+	if event.TicketURL != "" {
+		m.TicketURL = urltype.NewValue(event.TicketURL)
+	}
+}
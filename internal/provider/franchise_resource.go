@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/types/urltype"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &franchiseResource{}
+	_ resource.ResourceWithConfigure   = &franchiseResource{}
+	_ resource.ResourceWithImportState = &franchiseResource{}
+)
+
+// NewFranchiseResource is a helper function to simplify the provider implementation.
+func NewFranchiseResource() resource.Resource {
+	return &franchiseResource{}
+}
+
+// franchiseResource is the resource implementation.
+type franchiseResource struct {
+	client *apiClient
+}
+
+// franchiseResourceModel maps the resource schema data.
+type franchiseResourceModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Name     types.String            `tfsdk:"name"`
+	Branding *franchiseBrandingModel `tfsdk:"branding"`
+	Settings types.Map               `tfsdk:"settings"`
+	CafeIDs  types.Set               `tfsdk:"cafe_ids"`
+}
+
+// franchiseBrandingModel maps the branding shared by a franchise's cafes.
+type franchiseBrandingModel struct {
+	LogoURL      urltype.Value `tfsdk:"logo_url"`
+	PrimaryColor types.String  `tfsdk:"primary_color"`
+}
+
+// apiFranchise is the API representation of a franchise, which applies its
+// branding and settings to its member cafes. Members are added and removed
+// individually once the franchise exists, so CafeIDs is only sent on create.
+type apiFranchise struct {
+	ID       int                   `json:"id,omitempty"`
+	Name     string                `json:"name"`
+	Branding *apiFranchiseBranding `json:"branding"`
+	Settings map[string]string     `json:"settings"`
+	CafeIDs  []int                 `json:"cafe_ids,omitempty"`
+}
+
+// apiFranchiseBranding is the API representation of a franchise's branding.
+// Unset fields are empty.
+type apiFranchiseBranding struct {
+	LogoURL      string `json:"logo_url,omitempty"`
+	PrimaryColor string `json:"primary_color,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *franchiseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_franchise"
+}
+
+// Schema defines the schema for the resource.
+func (r *franchiseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"branding": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"logo_url": schema.StringAttribute{
+						CustomType: urltype.Type{},
+						Optional:   true,
+					},
+					// primary_color is a CSS hex color, such as "#6f4e37".
+					"primary_color": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(7, 7),
+						},
+					},
+				},
+			},
+			"settings": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			// Adding or removing a cafe only adds or removes that cafe, so
+			// the other members are left untouched.
+			"cafe_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *franchiseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan franchiseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	franchise, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cafeIDs []string
+	resp.Diagnostics.Append(plan.CafeIDs.ElementsAs(ctx, &cafeIDs, false)...)
+	for _, cafeID := range cafeIDs {
+		franchise.CafeIDs = append(franchise.CafeIDs, parseObjectID(path.Root("cafe_ids"), cafeID, &resp.Diagnostics))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiFranchise
+	err := r.client.do(ctx, http.MethodPost, "/franchises", franchise, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating franchise",
+			"Could not create franchise, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *franchiseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state franchiseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var franchise apiFranchise
+	err := r.client.do(ctx, http.MethodGet, "/franchises/"+state.ID.ValueString(), nil, &franchise)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups franchise not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Franchise",
+			"Could not read HashiCups franchise ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(ctx, franchise)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the franchise, then adds the cafes that joined it and
+// removes the cafes that left it.
+func (r *franchiseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state franchiseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	franchise, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planCafeIDs, stateCafeIDs []string
+	resp.Diagnostics.Append(plan.CafeIDs.ElementsAs(ctx, &planCafeIDs, false)...)
+	resp.Diagnostics.Append(state.CafeIDs.ElementsAs(ctx, &stateCafeIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiFranchise
+	err := r.client.do(ctx, http.MethodPut, "/franchises/"+plan.ID.ValueString(), franchise, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Franchise",
+			"Could not update franchise, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	added, removed := diffStrings(stateCafeIDs, planCafeIDs)
+	for _, cafeID := range added {
+		err := r.client.do(ctx, http.MethodPut, "/franchises/"+plan.ID.ValueString()+"/cafes/"+cafeID, nil, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating HashiCups Franchise",
+				"Could not add cafe ID "+cafeID+" to franchise, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	for _, cafeID := range removed {
+		err := r.client.do(ctx, http.MethodDelete, "/franchises/"+plan.ID.ValueString()+"/cafes/"+cafeID, nil, nil)
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Updating HashiCups Franchise",
+				"Could not remove cafe ID "+cafeID+" from franchise, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// The cafes of the update response predate the membership changes.
+	cafeIDs := plan.CafeIDs
+	resp.Diagnostics.Append(plan.fromAPI(ctx, updated)...)
+	plan.CafeIDs = cafeIDs
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *franchiseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state franchiseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/franchises/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Franchise Already Deleted",
+			"The franchise was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Franchise",
+			"Could not delete franchise, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *franchiseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing franchise by its ID.
+func (r *franchiseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model, without the
+// member cafes.
+func (m franchiseResourceModel) toAPI(ctx context.Context) (apiFranchise, diag.Diagnostics) {
+	franchise := apiFranchise{
+		Name:     m.Name.ValueString(),
+		Settings: map[string]string{},
+	}
+	if m.Branding != nil {
+		franchise.Branding = &apiFranchiseBranding{
+			LogoURL:      m.Branding.LogoURL.ValueString(),
+			PrimaryColor: m.Branding.PrimaryColor.ValueString(),
+		}
+	}
+	diags := m.Settings.ElementsAs(ctx, &franchise.Settings, false)
+
+	return franchise, diags
+}
+
+// fromAPI maps an API response body onto the resource model. Empty settings
+// are kept null.
+func (m *franchiseResourceModel) fromAPI(ctx context.Context, franchise apiFranchise) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.Itoa(franchise.ID))
+	m.Name = types.StringValue(franchise.Name)
+
+	m.Branding = nil
+	if branding := franchise.Branding; branding != nil {
+		m.Branding = &franchiseBrandingModel{
+			LogoURL:      urltype.NewNull(),
+			PrimaryColor: stringValueOrNull(branding.PrimaryColor),
+		}
+		if branding.LogoURL != "" {
+			m.Branding.LogoURL = urltype.NewValue(branding.LogoURL)
+		}
+	}
+
+	m.Settings = types.MapNull(types.StringType)
+	if len(franchise.Settings) > 0 {
+		var d diag.Diagnostics
+		m.Settings, d = types.MapValueFrom(ctx, types.StringType, franchise.Settings)
+		diags.Append(d...)
+	}
+
+	cafeIDs := make([]string, 0, len(franchise.CafeIDs))
+	for _, cafeID := range franchise.CafeIDs {
+		cafeIDs = append(cafeIDs, strconv.Itoa(cafeID))
+	}
+	var d diag.Diagnostics
+	m.CafeIDs, d = types.SetValueFrom(ctx, types.StringType, cafeIDs)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+)
+
+// geoPoint is a point in decimal degrees. Delivery zones are small enough to
+// treat latitude and longitude as plane coordinates when validating their
+// shapes.
+type geoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// validatePolygon checks that points form a simple polygon: at least three
+// distinct vertices, no two consecutive vertices equal, and no edges that
+// cross each other. The polygon is implicitly closed, so the last vertex
+// must not repeat the first.
+func validatePolygon(points []geoPoint) error {
+	if len(points) < 3 {
+		return fmt.Errorf("a polygon needs at least 3 points, got %d", len(points))
+	}
+
+	n := len(points)
+	for i := range points {
+		if points[i] == points[(i+1)%n] {
+			return fmt.Errorf("points %d and %d are equal; the polygon is closed automatically", i, (i+1)%n)
+		}
+	}
+
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			// Adjacent edges share a vertex, which is not a crossing.
+			if j == i+1 || (i == 0 && j == n-1) {
+				continue
+			}
+
+			if segmentsIntersect(points[i], points[(i+1)%n], points[j], points[(j+1)%n]) {
+				return fmt.Errorf("edges %d and %d cross each other", i, j)
+			}
+		}
+	}
+
+	if polygonArea(points) == 0 {
+		return errors.New("the polygon has no area")
+	}
+
+	return nil
+}
+
+// segmentsIntersect reports whether the line segments pq and rs have any
+// point in common.
+func segmentsIntersect(p, q, r, s geoPoint) bool {
+	d1 := orientation(r, s, p)
+	d2 := orientation(r, s, q)
+	d3 := orientation(p, q, r)
+	d4 := orientation(p, q, s)
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+
+	return (d1 == 0 && onSegment(r, s, p)) ||
+		(d2 == 0 && onSegment(r, s, q)) ||
+		(d3 == 0 && onSegment(p, q, r)) ||
+		(d4 == 0 && onSegment(p, q, s))
+}
+
+// orientation returns the cross product of ab and ac, which is positive when
+// c lies to the left of ab, negative when it lies to the right, and zero
+// when the three points are collinear.
+func orientation(a, b, c geoPoint) float64 {
+	return (b.Longitude-a.Longitude)*(c.Latitude-a.Latitude) - (b.Latitude-a.Latitude)*(c.Longitude-a.Longitude)
+}
+
+// onSegment reports whether c, which is collinear with ab, lies on the
+// segment ab.
+func onSegment(a, b, c geoPoint) bool {
+	return min(a.Latitude, b.Latitude) <= c.Latitude && c.Latitude <= max(a.Latitude, b.Latitude) &&
+		min(a.Longitude, b.Longitude) <= c.Longitude && c.Longitude <= max(a.Longitude, b.Longitude)
+}
+
+// polygonArea returns twice the signed area of the polygon.
+func polygonArea(points []geoPoint) float64 {
+	var area float64
+	for i, p := range points {
+		q := points[(i+1)%len(points)]
+		area += p.Longitude*q.Latitude - q.Longitude*p.Latitude
+	}
+
+	return area
+}
@@ -0,0 +1,53 @@
+package provider
+
+import "testing"
+
+func TestValidatePolygon(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		points      []geoPoint
+		expectError bool
+	}{
+		"triangle": {
+			points: []geoPoint{{0, 0}, {0, 1}, {1, 0}},
+		},
+		"square": {
+			points: []geoPoint{{37.56, 126.97}, {37.56, 126.99}, {37.58, 126.99}, {37.58, 126.97}},
+		},
+		"concave": {
+			points: []geoPoint{{0, 0}, {0, 4}, {2, 2}, {4, 4}, {4, 0}},
+		},
+		"too-few-points": {
+			points:      []geoPoint{{0, 0}, {0, 1}},
+			expectError: true,
+		},
+		"closed-explicitly": {
+			points:      []geoPoint{{0, 0}, {0, 1}, {1, 0}, {0, 0}},
+			expectError: true,
+		},
+		"repeated-point": {
+			points:      []geoPoint{{0, 0}, {0, 1}, {0, 1}, {1, 0}},
+			expectError: true,
+		},
+		"bow-tie": {
+			points:      []geoPoint{{0, 0}, {1, 1}, {1, 0}, {0, 1}},
+			expectError: true,
+		},
+		"collinear": {
+			points:      []geoPoint{{0, 0}, {1, 1}, {2, 2}},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validatePolygon(testCase.points)
+			if got := err != nil; got != testCase.expectError {
+				t.Errorf("expected error %t, got: %v", testCase.expectError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &giftCardResource{}
+	_ resource.ResourceWithConfigure   = &giftCardResource{}
+	_ resource.ResourceWithImportState = &giftCardResource{}
+)
+
+// NewGiftCardResource is a helper function to simplify the provider implementation.
+func NewGiftCardResource() resource.Resource {
+	return &giftCardResource{}
+}
+
+// giftCardResource is the resource implementation.
+type giftCardResource struct {
+	client *apiClient
+}
+
+// giftCardResourceModel maps the resource schema data.
+type giftCardResourceModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	InitialBalance      decimal.Value `tfsdk:"initial_balance"`
+	Currency            types.String  `tfsdk:"currency"`
+	RecipientEmail      types.String  `tfsdk:"recipient_email"`
+	CardNumber          types.String  `tfsdk:"card_number"`
+	Balance             decimal.Value `tfsdk:"balance"`
+	Active              types.Bool    `tfsdk:"active"`
+	DeactivateOnDestroy types.Bool    `tfsdk:"deactivate_on_destroy"`
+}
+
+// apiGiftCard is the API representation of a gift card. Gift cards cannot
+// be deleted, only deactivated, so that issued balances stay accounted for.
+// Active is left out of updates so that they keep whether the card is
+// active, as only creating the card activates it.
+type apiGiftCard struct {
+	ID             int         `json:"id,omitempty"`
+	InitialBalance json.Number `json:"initial_balance"`
+	Currency       string      `json:"currency"`
+	RecipientEmail string      `json:"recipient_email"`
+	CardNumber     string      `json:"card_number,omitempty"`
+	Balance        json.Number `json:"balance,omitempty"`
+	Active         *bool       `json:"active,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *giftCardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gift_card"
+}
+
+// Schema defines the schema for the resource.
+func (r *giftCardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"initial_balance": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// currency is an ISO 4217 currency code, such as "USD".
+			"currency": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 3),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recipient_email": schema.StringAttribute{
+				Required: true,
+			},
+			// The card number is what redeems the card, so it is treated
+			// like a credential.
+			"card_number": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"balance": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Computed:   true,
+			},
+			"active": schema.BoolAttribute{
+				Computed: true,
+			},
+			// Destroying a gift card deactivates it. With
+			// deactivate_on_destroy set to false the card is only removed
+			// from state and stays redeemable.
+			"deactivate_on_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *giftCardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan giftCardResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := plan.toAPI()
+	active := true
+	body.Active = &active
+
+	var card apiGiftCard
+	err := r.client.do(ctx, http.MethodPost, "/gift-cards", body, &card)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating gift card",
+			"Could not create gift card, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(card)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *giftCardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state giftCardResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var card apiGiftCard
+	err := r.client.do(ctx, http.MethodGet, "/gift-cards/"+state.ID.ValueString(), nil, &card)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups gift card not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Gift Card",
+			"Could not read HashiCups gift card ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(card)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the recipient of the gift card. A card that was deactivated
+// outside Terraform stays deactivated.
+func (r *giftCardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan giftCardResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var card apiGiftCard
+	err := r.client.do(ctx, http.MethodPut, "/gift-cards/"+plan.ID.ValueString(), plan.toAPI(), &card)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Gift Card",
+			"Could not update gift card, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(card)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deactivates the gift card, unless deactivate_on_destroy is false.
+func (r *giftCardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state giftCardResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.DeactivateOnDestroy.ValueBool() {
+		tflog.Info(ctx, "Removing HashiCups gift card from state without deactivating it", map[string]any{"id": state.ID.ValueString()})
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodPost, "/gift-cards/"+state.ID.ValueString()+"/deactivate", nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Gift Card Already Deleted",
+			"The gift card was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deactivating HashiCups Gift Card",
+			"Could not deactivate gift card, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *giftCardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing gift card by its ID. Imported cards are
+// deactivated on destroy like created ones, even before the next apply sets
+// deactivate_on_destroy.
+func (r *giftCardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deactivate_on_destroy"), true)...)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m giftCardResourceModel) toAPI() apiGiftCard {
+	return apiGiftCard{
+		InitialBalance: json.Number(m.InitialBalance.ValueString()),
+		Currency:       m.Currency.ValueString(),
+		RecipientEmail: m.RecipientEmail.ValueString(),
+	}
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *giftCardResourceModel) fromAPI(card apiGiftCard) {
+	m.ID = types.StringValue(strconv.Itoa(card.ID))
+	m.InitialBalance = decimal.NewValue(card.InitialBalance.String())
+	m.Currency = types.StringValue(card.Currency)
+	m.RecipientEmail = types.StringValue(card.RecipientEmail)
+	m.CardNumber = types.StringValue(card.CardNumber)
+	m.Balance = decimal.NewNull()
+	if card.Balance != "" {
+		m.Balance = decimal.NewValue(card.Balance.String())
+	}
+	m.Active = types.BoolValue(card.Active != nil && *card.Active)
+}
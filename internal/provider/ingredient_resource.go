@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ingredientResource{}
+	_ resource.ResourceWithConfigure   = &ingredientResource{}
+	_ resource.ResourceWithImportState = &ingredientResource{}
+)
+
+// NewIngredientResource is a helper function to simplify the provider implementation.
+func NewIngredientResource() resource.Resource {
+	return &ingredientResource{}
+}
+
+// ingredientResource is the resource implementation.
+type ingredientResource struct {
+	client *apiClient
+}
+
+// ingredientResourceModel maps the resource schema data.
+type ingredientResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Quantity types.Int64  `tfsdk:"quantity"`
+	Unit     types.String `tfsdk:"unit"`
+}
+
+// Metadata returns the resource type name.
+func (r *ingredientResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingredient"
+}
+
+// Schema defines the schema for the resource.
+func (r *ingredientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"quantity": schema.Int64Attribute{
+				Required: true,
+			},
+			"unit": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *ingredientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ingredientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingredient hashicups.Ingredient
+	etag, err := r.client.doConditional(ctx, http.MethodPost, "/ingredients", "", plan.toAPI(), &ingredient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ingredient",
+			"Could not create ingredient, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(ingredient)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+// Read resource information.
+func (r *ingredientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ingredientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingredient hashicups.Ingredient
+	etag, err := r.client.doConditional(ctx, http.MethodGet, "/ingredients/"+state.ID.ValueString(), "", nil, &ingredient)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups ingredient not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Ingredient",
+			"Could not read HashiCups ingredient ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(ingredient)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+func (r *ingredientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ingredientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifMatch, diags := getPrivateString(ctx, req.Private, etagPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ingredient hashicups.Ingredient
+	etag, err := r.client.doConditional(ctx, http.MethodPut, "/ingredients/"+plan.ID.ValueString(), ifMatch, plan.toAPI(), &ingredient)
+	if isPreconditionFailed(err) {
+		resp.Diagnostics.AddError(
+			"HashiCups Ingredient Modified Outside Terraform",
+			"The ingredient was changed since Terraform last read it, so the update was rejected to avoid overwriting those changes. "+
+				"Refresh the state, review the differences and apply again.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Ingredient",
+			"Could not update ingredient, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(ingredient)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+func (r *ingredientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ingredientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifMatch, diags := getPrivateString(ctx, req.Private, etagPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.doConditional(ctx, http.MethodDelete, "/ingredients/"+state.ID.ValueString(), ifMatch, nil, nil)
+	if isPreconditionFailed(err) {
+		resp.Diagnostics.AddError(
+			"HashiCups Ingredient Modified Outside Terraform",
+			"The ingredient was changed since Terraform last read it, so the delete was rejected. "+
+				"Refresh the state, review the differences and apply again.",
+		)
+		return
+	}
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Ingredient Already Deleted",
+			"The ingredient was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Ingredient",
+			"Could not delete ingredient, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ingredientResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing ingredient by its ID.
+func (r *ingredientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m ingredientResourceModel) toAPI() hashicups.Ingredient {
+	return hashicups.Ingredient{
+		Name:     m.Name.ValueString(),
+		Quantity: int(m.Quantity.ValueInt64()),
+		Unit:     m.Unit.ValueString(),
+	}
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *ingredientResourceModel) fromAPI(ingredient hashicups.Ingredient) {
+	m.ID = types.StringValue(strconv.Itoa(ingredient.ID))
+	m.Name = types.StringValue(ingredient.Name)
+	m.Quantity = types.Int64Value(int64(ingredient.Quantity))
+	m.Unit = types.StringValue(ingredient.Unit)
+}
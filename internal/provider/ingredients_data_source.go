@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ingredientsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ingredientsDataSource{}
+)
+
+// NewIngredientsDataSource is a helper function to simplify the provider implementation.
+func NewIngredientsDataSource() datasource.DataSource {
+	return &ingredientsDataSource{}
+}
+
+// ingredientsDataSource is the data source implementation.
+type ingredientsDataSource struct {
+	client *apiClient
+}
+
+// ingredientsDataSourceModel maps the data source schema data.
+type ingredientsDataSourceModel struct {
+	MaxItems          types.Int64                 `tfsdk:"max_items"`
+	PageSize          types.Int64                 `tfsdk:"page_size"`
+	SortBy            types.String                `tfsdk:"sort_by"`
+	SortOrder         types.String                `tfsdk:"sort_order"`
+	Ingredients       []ingredientsModel          `tfsdk:"ingredients"`
+	IngredientsByName map[string]ingredientsModel `tfsdk:"ingredients_by_name"`
+}
+
+// ingredientsModel maps ingredients schema data.
+type ingredientsModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Quantity types.Int64  `tfsdk:"quantity"`
+	Unit     types.String `tfsdk:"unit"`
+}
+
+// ingredientsSortFields are the fields the ingredients can be sorted by.
+var ingredientsSortFields = sortFields[hashicups.Ingredient]{
+	"id": func(a, b hashicups.Ingredient) int {
+		return cmp.Compare(a.ID, b.ID)
+	},
+	"name": func(a, b hashicups.Ingredient) int {
+		return cmp.Compare(a.Name, b.Name)
+	},
+	"quantity": func(a, b hashicups.Ingredient) int {
+		return cmp.Compare(a.Quantity, b.Quantity)
+	},
+}
+
+// Metadata returns the data source type name.
+func (d *ingredientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingredients"
+}
+
+// Schema defines the schema for the data source.
+func (d *ingredientsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ingredientAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"quantity": schema.Int64Attribute{
+			Computed: true,
+		},
+		"unit": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"max_items":  maxItemsAttribute(),
+			"page_size":  pageSizeAttribute(),
+			"sort_by":    sortByAttribute(ingredientsSortFields),
+			"sort_order": sortOrderAttribute(),
+			"ingredients": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ingredientAttributes,
+				},
+			},
+			// ingredients_by_name holds the same ingredients keyed by name, so
+			// that they can be indexed directly. The first of several
+			// ingredients with the same name is kept.
+			"ingredients_by_name": schema.MapNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ingredientAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ingredientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ingredientsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ingredients, err := listSorted[hashicups.Ingredient](ctx, d.client, "/ingredients", nil, newListOptions(state.MaxItems, state.PageSize), ingredientsSortFields, state.SortBy, state.SortOrder, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Ingredients",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Ingredients = []ingredientsModel{}
+	for _, ingredient := range ingredients {
+		state.Ingredients = append(state.Ingredients, ingredientsModel{
+			ID:       types.StringValue(strconv.Itoa(ingredient.ID)),
+			Name:     types.StringValue(ingredient.Name),
+			Quantity: types.Int64Value(int64(ingredient.Quantity)),
+			Unit:     types.StringValue(ingredient.Unit),
+		})
+	}
+
+	var duplicates []string
+	state.IngredientsByName, duplicates = indexByName(state.Ingredients, func(ingredient ingredientsModel) string {
+		return ingredient.Name.ValueString()
+	})
+	if len(duplicates) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Duplicate Ingredient Names",
+			fmt.Sprintf("Several ingredients share the names %q. Only the first ingredient with each name is in ingredients_by_name.", duplicates),
+		)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ingredientsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &inventoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &inventoryDataSource{}
+)
+
+// NewInventoryDataSource is a helper function to simplify the provider implementation.
+func NewInventoryDataSource() datasource.DataSource {
+	return &inventoryDataSource{}
+}
+
+// inventoryDataSource is the data source implementation.
+type inventoryDataSource struct {
+	client *apiClient
+}
+
+// inventoryDataSourceModel maps the data source schema data.
+type inventoryDataSourceModel struct {
+	CafeID    types.String          `tfsdk:"cafe_id"`
+	MaxItems  types.Int64           `tfsdk:"max_items"`
+	PageSize  types.Int64           `tfsdk:"page_size"`
+	SortBy    types.String          `tfsdk:"sort_by"`
+	SortOrder types.String          `tfsdk:"sort_order"`
+	Items     []inventoryItemsModel `tfsdk:"items"`
+}
+
+// inventoryItemsModel maps the stock of an ingredient at the cafe.
+type inventoryItemsModel struct {
+	IngredientID     types.String `tfsdk:"ingredient_id"`
+	Quantity         types.Int64  `tfsdk:"quantity"`
+	ReorderThreshold types.Int64  `tfsdk:"reorder_threshold"`
+	BelowThreshold   types.Bool   `tfsdk:"below_threshold"`
+}
+
+// apiListedInventoryItem is the API representation of the stock of an
+// ingredient as listed by the inventory endpoint of a cafe, which unlike
+// apiInventoryItem identifies the ingredient in the body.
+type apiListedInventoryItem struct {
+	IngredientID int `json:"ingredient_id"`
+	apiInventoryItem
+}
+
+// inventorySortFields are the fields the stock levels of a cafe can be sorted by.
+var inventorySortFields = sortFields[apiListedInventoryItem]{
+	"ingredient_id": func(a, b apiListedInventoryItem) int {
+		return cmp.Compare(a.IngredientID, b.IngredientID)
+	},
+	"quantity": func(a, b apiListedInventoryItem) int {
+		return cmp.Compare(a.Quantity, b.Quantity)
+	},
+}
+
+// Metadata returns the data source type name.
+func (d *inventoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory"
+}
+
+// Schema defines the schema for the data source.
+func (d *inventoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+			},
+			"max_items":  maxItemsAttribute(),
+			"page_size":  pageSizeAttribute(),
+			"sort_by":    sortByAttribute(inventorySortFields),
+			"sort_order": sortOrderAttribute(),
+			"items": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ingredient_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"quantity": schema.Int64Attribute{
+							Computed: true,
+						},
+						"reorder_threshold": schema.Int64Attribute{
+							Computed: true,
+						},
+						// below_threshold is whether the quantity is at or
+						// below the reorder threshold. It is false for
+						// ingredients without a threshold.
+						"below_threshold": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the stock levels at the cafe.
+func (d *inventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state inventoryDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := listSorted[apiListedInventoryItem](ctx, d.client, "/cafes/"+state.CafeID.ValueString()+"/inventory", nil, newListOptions(state.MaxItems, state.PageSize), inventorySortFields, state.SortBy, state.SortOrder, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cafe_id"),
+			"Cafe Not Found",
+			fmt.Sprintf("No cafe found with the ID %q.", state.CafeID.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Inventory",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Items = []inventoryItemsModel{}
+	for _, item := range items {
+		state.Items = append(state.Items, inventoryItemsModel{
+			IngredientID:     types.StringValue(strconv.Itoa(item.IngredientID)),
+			Quantity:         types.Int64Value(item.Quantity),
+			ReorderThreshold: types.Int64PointerValue(item.ReorderThreshold),
+			BelowThreshold:   types.BoolValue(item.ReorderThreshold != nil && item.Quantity <= *item.ReorderThreshold),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *inventoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &inventoryResource{}
+	_ resource.ResourceWithConfigure   = &inventoryResource{}
+	_ resource.ResourceWithImportState = &inventoryResource{}
+)
+
+// inventoryChildType is the child type of the composite IDs of inventory
+// entries, which are of the form "cafe/<cafe_id>/ingredient/<ingredient_id>".
+const inventoryChildType = "ingredient"
+
+// NewInventoryResource is a helper function to simplify the provider implementation.
+func NewInventoryResource() resource.Resource {
+	return &inventoryResource{}
+}
+
+// inventoryResource is the resource implementation.
+type inventoryResource struct {
+	client *apiClient
+}
+
+// inventoryResourceModel maps the resource schema data.
+type inventoryResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	CafeID           types.String `tfsdk:"cafe_id"`
+	IngredientID     types.String `tfsdk:"ingredient_id"`
+	Quantity         types.Int64  `tfsdk:"quantity"`
+	ReorderThreshold types.Int64  `tfsdk:"reorder_threshold"`
+}
+
+// apiInventoryItem is the API representation of the stock of an ingredient
+// at a cafe. The cafe and ingredient are identified by the request path.
+type apiInventoryItem struct {
+	Quantity         int64  `json:"quantity"`
+	ReorderThreshold *int64 `json:"reorder_threshold,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *inventoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory"
+}
+
+// Schema defines the schema for the resource.
+func (r *inventoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ingredient_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quantity": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			// reorder_threshold is the quantity at or below which the
+			// ingredient is reordered.
+			"reorder_threshold": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *inventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan inventoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every cafe has a stock level for every ingredient, so creating the
+	// resource replaces whatever stock policy the cafe currently has.
+	var item apiInventoryItem
+	err := r.client.do(ctx, http.MethodPut, plan.apiPath(), plan.toAPI(), &item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating inventory",
+			"Could not create inventory, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(cafeCompositeID{
+		CafeID:    plan.CafeID.ValueString(),
+		ChildType: inventoryChildType,
+		ChildID:   plan.IngredientID.ValueString(),
+	}.String())
+	plan.fromAPI(item)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *inventoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state inventoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item apiInventoryItem
+	err := r.client.do(ctx, http.MethodGet, state.apiPath(), nil, &item)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups inventory not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Inventory",
+			"Could not read HashiCups inventory ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(item)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *inventoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan inventoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item apiInventoryItem
+	err := r.client.do(ctx, http.MethodPut, plan.apiPath(), plan.toAPI(), &item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Inventory",
+			"Could not update inventory, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(item)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *inventoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state inventoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, state.apiPath(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Inventory Already Deleted",
+			"The inventory was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Inventory",
+			"Could not delete inventory, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *inventoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the inventory of an ingredient at a cafe by its
+// composite ID, "cafe/<cafe_id>/ingredient/<ingredient_id>".
+func (r *inventoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := parseCafeID(req.ID)
+	if err == nil && id.ChildType != inventoryChildType {
+		err = fmt.Errorf("expected an ID of the form cafe/<cafe_id>/%s/<ingredient_id>, got: %q", inventoryChildType, req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Inventory Import ID",
+			"Could not parse the import ID: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cafe_id"), id.CafeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ingredient_id"), id.ChildID)...)
+}
+
+// apiPath returns the API path of the inventory entry.
+func (m inventoryResourceModel) apiPath() string {
+	return "/cafes/" + m.CafeID.ValueString() + "/inventory/" + m.IngredientID.ValueString()
+}
+
+// toAPI builds the API request body from the resource model.
+func (m inventoryResourceModel) toAPI() apiInventoryItem {
+	return apiInventoryItem{
+		Quantity:         m.Quantity.ValueInt64(),
+		ReorderThreshold: m.ReorderThreshold.ValueInt64Pointer(),
+	}
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *inventoryResourceModel) fromAPI(item apiInventoryItem) {
+	m.Quantity = types.Int64Value(item.Quantity)
+	m.ReorderThreshold = types.Int64PointerValue(item.ReorderThreshold)
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &loyaltyProgramResource{}
+	_ resource.ResourceWithConfigure   = &loyaltyProgramResource{}
+	_ resource.ResourceWithImportState = &loyaltyProgramResource{}
+)
+
+// NewLoyaltyProgramResource is a helper function to simplify the provider implementation.
+func NewLoyaltyProgramResource() resource.Resource {
+	return &loyaltyProgramResource{}
+}
+
+// loyaltyProgramResource is the resource implementation.
+type loyaltyProgramResource struct {
+	client *apiClient
+}
+
+// loyaltyProgramResourceModel maps the resource schema data.
+type loyaltyProgramResourceModel struct {
+	ID                    types.String      `tfsdk:"id"`
+	CafeID                types.String      `tfsdk:"cafe_id"`
+	Enabled               types.Bool        `tfsdk:"enabled"`
+	PointsPerCurrencyUnit decimal.Value     `tfsdk:"points_per_currency_unit"`
+	RewardTiers           []rewardTierModel `tfsdk:"reward_tiers"`
+}
+
+// rewardTierModel maps a reward tier of a loyalty program.
+type rewardTierModel struct {
+	Name      types.String `tfsdk:"name"`
+	MinPoints types.Int64  `tfsdk:"min_points"`
+	Reward    types.String `tfsdk:"reward"`
+}
+
+// apiLoyaltyProgram is the API representation of a cafe's loyalty program.
+type apiLoyaltyProgram struct {
+	Enabled               bool            `json:"enabled"`
+	PointsPerCurrencyUnit json.Number     `json:"points_per_currency_unit"`
+	RewardTiers           []apiRewardTier `json:"reward_tiers"`
+}
+
+// apiRewardTier is the API representation of a reward tier, which members
+// reach once they have collected MinPoints points.
+type apiRewardTier struct {
+	Name      string `json:"name"`
+	MinPoints int64  `json:"min_points"`
+	Reward    string `json:"reward"`
+}
+
+// Metadata returns the resource type name.
+func (r *loyaltyProgramResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_loyalty_program"
+}
+
+// Schema defines the schema for the resource.
+func (r *loyaltyProgramResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"points_per_currency_unit": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Required:   true,
+			},
+			"reward_tiers": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"min_points": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"reward": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *loyaltyProgramResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan loyaltyProgramResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A cafe has at most one loyalty program, so creating the resource
+	// replaces whatever program the cafe currently has.
+	var program apiLoyaltyProgram
+	err := r.client.do(ctx, http.MethodPut, "/cafes/"+plan.CafeID.ValueString()+"/loyalty-program", plan.toAPI(), &program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating loyalty program",
+			"Could not create loyalty program, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.CafeID
+	plan.fromAPI(program)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *loyaltyProgramResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state loyaltyProgramResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var program apiLoyaltyProgram
+	err := r.client.do(ctx, http.MethodGet, "/cafes/"+state.ID.ValueString()+"/loyalty-program", nil, &program)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups loyalty program not found, removing from state", map[string]any{"cafe_id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Loyalty Program",
+			"Could not read loyalty program for HashiCups cafe ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.CafeID = state.ID
+	state.fromAPI(program)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *loyaltyProgramResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan loyaltyProgramResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var program apiLoyaltyProgram
+	err := r.client.do(ctx, http.MethodPut, "/cafes/"+plan.ID.ValueString()+"/loyalty-program", plan.toAPI(), &program)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Loyalty Program",
+			"Could not update loyalty program, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(program)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *loyaltyProgramResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state loyaltyProgramResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/cafes/"+state.ID.ValueString()+"/loyalty-program", nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Loyalty Program Already Deleted",
+			"The loyalty program was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Loyalty Program",
+			"Could not delete loyalty program, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *loyaltyProgramResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the loyalty program of an existing cafe by the cafe
+// ID.
+func (r *loyaltyProgramResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m loyaltyProgramResourceModel) toAPI() apiLoyaltyProgram {
+	program := apiLoyaltyProgram{
+		Enabled:               m.Enabled.ValueBool(),
+		PointsPerCurrencyUnit: json.Number(m.PointsPerCurrencyUnit.ValueString()),
+		RewardTiers:           []apiRewardTier{},
+	}
+	for _, tier := range m.RewardTiers {
+		program.RewardTiers = append(program.RewardTiers, apiRewardTier{
+			Name:      tier.Name.ValueString(),
+			MinPoints: tier.MinPoints.ValueInt64(),
+			Reward:    tier.Reward.ValueString(),
+		})
+	}
+
+	return program
+}
+
+// fromAPI maps an API response body onto the resource model. A program
+// without reward tiers has null reward_tiers.
+func (m *loyaltyProgramResourceModel) fromAPI(program apiLoyaltyProgram) {
+	m.Enabled = types.BoolValue(program.Enabled)
+	m.PointsPerCurrencyUnit = decimal.NewValue(program.PointsPerCurrencyUnit.String())
+
+	m.RewardTiers = nil
+	for _, tier := range program.RewardTiers {
+		m.RewardTiers = append(m.RewardTiers, rewardTierModel{
+			Name:      types.StringValue(tier.Name),
+			MinPoints: types.Int64Value(tier.MinPoints),
+			Reward:    types.StringValue(tier.Reward),
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &menuItemResource{}
+	_ resource.ResourceWithConfigure   = &menuItemResource{}
+	_ resource.ResourceWithImportState = &menuItemResource{}
+)
+
+// menuItemChildType is the child type of the composite IDs of menu items,
+// which are of the form "cafe/<cafe_id>/coffee/<coffee_id>".
+const menuItemChildType = "coffee"
+
+// NewMenuItemResource is a helper function to simplify the provider implementation.
+func NewMenuItemResource() resource.Resource {
+	return &menuItemResource{}
+}
+
+// menuItemResource is the resource implementation.
+type menuItemResource struct {
+	client *apiClient
+}
+
+// menuItemResourceModel maps the resource schema data.
+type menuItemResourceModel struct {
+	ID        types.String  `tfsdk:"id"`
+	CafeID    types.String  `tfsdk:"cafe_id"`
+	CoffeeID  types.String  `tfsdk:"coffee_id"`
+	Price     decimal.Value `tfsdk:"price"`
+	Available types.Bool    `tfsdk:"available"`
+}
+
+// apiMenuItem is the API representation of a single coffee on a cafe's
+// menu. The cafe and coffee are identified by the request path. As with
+// cafeMenuItem, Price is only set when the cafe overrides the coffee's list
+// price.
+type apiMenuItem struct {
+	Price     *json.Number `json:"price,omitempty"`
+	Available bool         `json:"available"`
+}
+
+// Metadata returns the resource type name.
+func (r *menuItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_menu_item"
+}
+
+// Schema defines the schema for the resource. The menu of a cafe must be
+// managed either by menu item resources or by a hashicups_menu resource, not
+// both, as the menu resource removes the coffees it does not list.
+func (r *menuItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"coffee_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// price overrides the coffee's list price at this cafe.
+			"price": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Optional:   true,
+			},
+			// An unavailable coffee stays on the menu but cannot be ordered,
+			// such as while it is sold out.
+			"available": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *menuItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan menuItemResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adding a coffee that is already on the menu replaces its menu entry.
+	var item apiMenuItem
+	err := r.client.do(ctx, http.MethodPut, plan.apiPath(), plan.toAPI(), &item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating menu item",
+			"Could not create menu item, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(cafeCompositeID{
+		CafeID:    plan.CafeID.ValueString(),
+		ChildType: menuItemChildType,
+		ChildID:   plan.CoffeeID.ValueString(),
+	}.String())
+	plan.fromAPI(item)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *menuItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state menuItemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item apiMenuItem
+	err := r.client.do(ctx, http.MethodGet, state.apiPath(), nil, &item)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups menu item not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Menu Item",
+			"Could not read HashiCups menu item ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(item)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *menuItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan menuItemResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item apiMenuItem
+	err := r.client.do(ctx, http.MethodPut, plan.apiPath(), plan.toAPI(), &item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Menu Item",
+			"Could not update menu item, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(item)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *menuItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state menuItemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, state.apiPath(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Menu Item Already Deleted",
+			"The menu item was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Menu Item",
+			"Could not delete menu item, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *menuItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports a coffee on the menu of a cafe by its composite ID,
+// "cafe/<cafe_id>/coffee/<coffee_id>".
+func (r *menuItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := parseCafeID(req.ID)
+	if err == nil && id.ChildType != menuItemChildType {
+		err = fmt.Errorf("expected an ID of the form cafe/<cafe_id>/%s/<coffee_id>, got: %q", menuItemChildType, req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Menu Item Import ID",
+			"Could not parse the import ID: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cafe_id"), id.CafeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("coffee_id"), id.ChildID)...)
+}
+
+// apiPath returns the API path of the menu item.
+func (m menuItemResourceModel) apiPath() string {
+	return "/cafes/" + m.CafeID.ValueString() + "/menu/items/" + m.CoffeeID.ValueString()
+}
+
+// toAPI builds the API request body from the resource model.
+func (m menuItemResourceModel) toAPI() apiMenuItem {
+	item := apiMenuItem{
+		Available: m.Available.ValueBool(),
+	}
+	if !m.Price.IsNull() {
+		price := json.Number(m.Price.ValueString())
+		item.Price = &price
+	}
+
+	return item
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *menuItemResourceModel) fromAPI(item apiMenuItem) {
+	m.Price = decimal.NewNull()
+	if item.Price != nil {
+		m.Price = decimal.NewValue(item.Price.String())
+	}
+	m.Available = types.BoolValue(item.Available)
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &menuResource{}
+	_ resource.ResourceWithConfigure   = &menuResource{}
+	_ resource.ResourceWithImportState = &menuResource{}
+)
+
+// NewMenuResource is a helper function to simplify the provider implementation.
+func NewMenuResource() resource.Resource {
+	return &menuResource{}
+}
+
+// menuResource is the resource implementation.
+type menuResource struct {
+	client *apiClient
+}
+
+// menuResourceModel maps the resource schema data.
+type menuResourceModel struct {
+	ID      types.String      `tfsdk:"id"`
+	CafeID  types.String      `tfsdk:"cafe_id"`
+	Coffees []menuCoffeeModel `tfsdk:"coffees"`
+}
+
+// menuCoffeeModel maps menu coffee entry data.
+type menuCoffeeModel struct {
+	CoffeeID types.Int64   `tfsdk:"coffee_id"`
+	Price    decimal.Value `tfsdk:"price"`
+}
+
+// cafeMenu is the API representation of a cafe's menu.
+type cafeMenu struct {
+	Items []cafeMenuItem `json:"items"`
+}
+
+// cafeMenuItem is a single coffee on a cafe's menu. Price is only set when
+// the cafe overrides the coffee's list price. It is kept as a json.Number so
+// that the decimal is sent and read back exactly.
+type cafeMenuItem struct {
+	CoffeeID int          `json:"coffee_id"`
+	Price    *json.Number `json:"price,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *menuResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_menu"
+}
+
+// Schema defines the schema for the resource.
+func (r *menuResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"coffees": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"coffee_id": schema.Int64Attribute{
+							Required: true,
+						},
+						"price": schema.StringAttribute{
+							CustomType: decimal.Type{},
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *menuResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan menuResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A cafe always has exactly one menu, so creating the resource replaces
+	// whatever menu the cafe currently has.
+	var menu cafeMenu
+	etag, err := r.client.doConditional(ctx, http.MethodPut, "/cafes/"+plan.CafeID.ValueString()+"/menu", "", plan.toAPI(), &menu)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating menu",
+			"Could not create menu, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.CafeID
+	plan.fromAPI(menu)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+// Read resource information.
+func (r *menuResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state menuResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	menu, etag, err := r.client.getMenu(ctx, state.ID.ValueString())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups cafe menu not found, removing from state", map[string]any{"cafe_id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Menu",
+			"Could not read menu for HashiCups cafe ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.CafeID = state.ID
+	state.fromAPI(menu)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+func (r *menuResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan menuResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifMatch, diags := getPrivateString(ctx, req.Private, etagPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var menu cafeMenu
+	etag, err := r.client.doConditional(ctx, http.MethodPut, "/cafes/"+plan.ID.ValueString()+"/menu", ifMatch, plan.toAPI(), &menu)
+	if isPreconditionFailed(err) {
+		resp.Diagnostics.AddError(
+			"HashiCups Menu Modified Outside Terraform",
+			"The menu was changed since Terraform last read it, so the update was rejected to avoid overwriting those changes. "+
+				"Refresh the state, review the differences and apply again.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Menu",
+			"Could not update menu, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(menu)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+func (r *menuResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state menuResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifMatch, diags := getPrivateString(ctx, req.Private, etagPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.doConditional(ctx, http.MethodDelete, "/cafes/"+state.ID.ValueString()+"/menu", ifMatch, nil, nil)
+	if isPreconditionFailed(err) {
+		resp.Diagnostics.AddError(
+			"HashiCups Menu Modified Outside Terraform",
+			"The menu was changed since Terraform last read it, so the delete was rejected. "+
+				"Refresh the state, review the differences and apply again.",
+		)
+		return
+	}
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Menu Already Deleted",
+			"The menu was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Menu",
+			"Could not delete menu, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *menuResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the menu of an existing cafe by the cafe ID.
+func (r *menuResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m menuResourceModel) toAPI() cafeMenu {
+	menu := cafeMenu{Items: []cafeMenuItem{}}
+	for _, coffee := range m.Coffees {
+		item := cafeMenuItem{
+			CoffeeID: int(coffee.CoffeeID.ValueInt64()),
+		}
+		if !coffee.Price.IsNull() {
+			price := json.Number(coffee.Price.ValueString())
+			item.Price = &price
+		}
+
+		menu.Items = append(menu.Items, item)
+	}
+
+	return menu
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *menuResourceModel) fromAPI(menu cafeMenu) {
+	m.Coffees = []menuCoffeeModel{}
+	for _, item := range menu.Items {
+		price := decimal.NewNull()
+		if item.Price != nil {
+			price = decimal.NewValue(item.Price.String())
+		}
+
+		m.Coffees = append(m.Coffees, menuCoffeeModel{
+			CoffeeID: types.Int64Value(int64(item.CoffeeID)),
+			Price:    price,
+		})
+	}
+}
+
+// getMenu returns the menu of the cafe with the given ID and its ETag.
+func (c *apiClient) getMenu(ctx context.Context, cafeID string) (cafeMenu, string, error) {
+	var menu cafeMenu
+	etag, err := c.doConditional(ctx, http.MethodGet, "/cafes/"+cafeID+"/menu", "", nil, &menu)
+
+	return menu, etag, err
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/float64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxSearchRadius is the largest radius of a geo search in meters, which is
+// half the circumference of the Earth and so covers every cafe.
+const maxSearchRadius = 20037508
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nearestCafeDataSource{}
+	_ datasource.DataSourceWithConfigure = &nearestCafeDataSource{}
+)
+
+// NewNearestCafeDataSource is a helper function to simplify the provider implementation.
+func NewNearestCafeDataSource() datasource.DataSource {
+	return &nearestCafeDataSource{}
+}
+
+// nearestCafeDataSource is the data source implementation.
+type nearestCafeDataSource struct {
+	client *apiClient
+}
+
+// nearestCafeDataSourceModel maps the data source schema data.
+type nearestCafeDataSourceModel struct {
+	Latitude  types.Float64      `tfsdk:"latitude"`
+	Longitude types.Float64      `tfsdk:"longitude"`
+	Radius    types.Float64      `tfsdk:"radius"`
+	Limit     types.Int64        `tfsdk:"limit"`
+	Cafes     []nearbyCafesModel `tfsdk:"cafes"`
+}
+
+// nearbyCafesModel maps a cafe found by a geo search and its distance.
+type nearbyCafesModel struct {
+	ID        types.String  `tfsdk:"id"`
+	Name      types.String  `tfsdk:"name"`
+	Address   types.String  `tfsdk:"address"`
+	Latitude  types.Float64 `tfsdk:"latitude"`
+	Longitude types.Float64 `tfsdk:"longitude"`
+	Distance  types.Float64 `tfsdk:"distance"`
+}
+
+// apiNearbyCafe is the API representation of a cafe found by the geo search
+// endpoint. Distance is in meters from the searched coordinates.
+type apiNearbyCafe struct {
+	ID       int         `json:"id"`
+	Name     string      `json:"name"`
+	Address  string      `json:"address"`
+	Location apiLocation `json:"location"`
+	Distance float64     `json:"distance"`
+}
+
+// Metadata returns the data source type name.
+func (d *nearestCafeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nearest_cafe"
+}
+
+// Schema defines the schema for the data source.
+func (d *nearestCafeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"latitude": schema.Float64Attribute{
+				Required: true,
+				Validators: []validator.Float64{
+					float64validator.Between(-90, 90),
+				},
+			},
+			"longitude": schema.Float64Attribute{
+				Required: true,
+				Validators: []validator.Float64{
+					float64validator.Between(-180, 180),
+				},
+			},
+			// radius is in meters, like the radius of a delivery zone.
+			"radius": schema.Float64Attribute{
+				Required: true,
+				Validators: []validator.Float64{
+					float64validator.Between(1, maxSearchRadius),
+				},
+			},
+			// limit caps the number of cafes, keeping the closest ones.
+			"limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cafes": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"address": schema.StringAttribute{
+							Computed: true,
+						},
+						"latitude": schema.Float64Attribute{
+							Computed: true,
+						},
+						"longitude": schema.Float64Attribute{
+							Computed: true,
+						},
+						// distance is in meters from the searched
+						// coordinates.
+						"distance": schema.Float64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read searches for the cafes within radius of the coordinates and refreshes
+// the Terraform state with them, closest first.
+func (d *nearestCafeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nearestCafeDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{
+		"latitude":  {strconv.FormatFloat(state.Latitude.ValueFloat64(), 'f', -1, 64)},
+		"longitude": {strconv.FormatFloat(state.Longitude.ValueFloat64(), 'f', -1, 64)},
+		"radius":    {strconv.FormatFloat(state.Radius.ValueFloat64(), 'f', -1, 64)},
+	}
+
+	var cafes []apiNearbyCafe
+	err := d.client.do(ctx, http.MethodGet, "/cafes/search?"+query.Encode(), nil, &cafes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Search HashiCups Cafes",
+			err.Error(),
+		)
+		return
+	}
+
+	// The API doesn't promise an order, so sort the cafes here to keep the
+	// closest ones under limit and the list stable between reads.
+	slices.SortStableFunc(cafes, func(a, b apiNearbyCafe) int {
+		return cmp.Compare(a.Distance, b.Distance)
+	})
+	if !state.Limit.IsNull() && int64(len(cafes)) > state.Limit.ValueInt64() {
+		cafes = cafes[:state.Limit.ValueInt64()]
+	}
+
+	// Map response body to model
+	state.Cafes = []nearbyCafesModel{}
+	for _, cafe := range cafes {
+		state.Cafes = append(state.Cafes, nearbyCafesModel{
+			ID:        types.StringValue(strconv.Itoa(cafe.ID)),
+			Name:      types.StringValue(cafe.Name),
+			Address:   types.StringValue(cafe.Address),
+			Latitude:  types.Float64Value(cafe.Location.Latitude),
+			Longitude: types.Float64Value(cafe.Location.Longitude),
+			Distance:  types.Float64Value(cafe.Distance),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nearestCafeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-inpyu-ossca/internal/types/normalizedstring"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &normalizeAddressFunction{}
+)
+
+// NewNormalizeAddressFunction is a helper function to simplify the provider implementation.
+func NewNormalizeAddressFunction() function.Function {
+	return &normalizeAddressFunction{}
+}
+
+// normalizeAddressFunction is the function implementation.
+type normalizeAddressFunction struct{}
+
+// Metadata returns the function name.
+func (f *normalizeAddressFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_address"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *normalizeAddressFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a cafe address",
+		Description: "Returns the canonical form of an address: lowercase, without surrounding whitespace. " +
+			"Two addresses with the same canonical form are considered equal by the cafe resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "The address to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the address.
+func (f *normalizeAddressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &address))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalizedstring.Normalize(address)))
+}
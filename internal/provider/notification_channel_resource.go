@@ -0,0 +1,392 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/types/urltype"
+	"terraform-provider-inpyu-ossca/internal/validators/configvalidator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &notificationChannelResource{}
+	_ resource.ResourceWithConfigure        = &notificationChannelResource{}
+	_ resource.ResourceWithConfigValidators = &notificationChannelResource{}
+	_ resource.ResourceWithValidateConfig   = &notificationChannelResource{}
+	_ resource.ResourceWithImportState      = &notificationChannelResource{}
+)
+
+// notificationChannelTypes are the kinds of channel alerts can be sent to.
+var notificationChannelTypes = []string{"email", "slack", "sms"}
+
+// notificationChannelDestinations maps each type of channel to the attribute
+// holding where its alerts are sent.
+var notificationChannelDestinations = map[string]string{
+	"email": "email",
+	"slack": "webhook_url",
+	"sms":   "phone_number",
+}
+
+// notificationEvents are the events alerts are sent for.
+var notificationEvents = []string{"low_stock", "order_placed", "order_failed"}
+
+// NewNotificationChannelResource is a helper function to simplify the provider implementation.
+func NewNotificationChannelResource() resource.Resource {
+	return &notificationChannelResource{}
+}
+
+// notificationChannelResource is the resource implementation.
+type notificationChannelResource struct {
+	client *apiClient
+}
+
+// notificationChannelResourceModel maps the resource schema data.
+type notificationChannelResourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Type        types.String  `tfsdk:"type"`
+	Email       types.String  `tfsdk:"email"`
+	WebhookURL  urltype.Value `tfsdk:"webhook_url"`
+	PhoneNumber types.String  `tfsdk:"phone_number"`
+	Events      types.Set     `tfsdk:"events"`
+}
+
+// apiNotificationChannel is the API representation of a channel that alerts
+// are sent to. Only the destination of the channel's type is set, and the
+// API never returns WebhookURL, as it acts as a credential.
+type apiNotificationChannel struct {
+	ID          int      `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Email       string   `json:"email,omitempty"`
+	WebhookURL  string   `json:"webhook_url,omitempty"`
+	PhoneNumber string   `json:"phone_number,omitempty"`
+	Events      []string `json:"events"`
+}
+
+// Metadata returns the resource type name.
+func (r *notificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+// Schema defines the schema for the resource.
+func (r *notificationChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(notificationChannelTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional: true,
+			},
+			// Anyone with the webhook URL can post to the Slack channel, so
+			// it is treated as a credential. The API never returns it, so it
+			// is kept as configured.
+			"webhook_url": schema.StringAttribute{
+				CustomType: urltype.Type{},
+				Optional:   true,
+				Sensitive:  true,
+			},
+			"phone_number": schema.StringAttribute{
+				Optional: true,
+			},
+			"events": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires the channel to have exactly one destination.
+func (r *notificationChannelResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		configvalidator.ExactlyOneOf(
+			path.MatchRoot("email"),
+			path.MatchRoot("webhook_url"),
+			path.MatchRoot("phone_number"),
+		),
+	}
+}
+
+// ValidateConfig checks that the destination is the one used by the
+// channel's type, and that alerts are sent for each of the events.
+func (r *notificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config notificationChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsUnknown() && !config.Type.IsNull() {
+		destinations := map[string]attr.Value{
+			"email":        config.Email,
+			"webhook_url":  config.WebhookURL,
+			"phone_number": config.PhoneNumber,
+		}
+		attribute := notificationChannelDestinations[config.Type.ValueString()]
+		if destination, ok := destinations[attribute]; ok && destination.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Notification Channel Destination",
+				fmt.Sprintf("Channels of type %q send alerts to %s, so it must be set.", config.Type.ValueString(), attribute),
+			)
+		}
+	}
+
+	if config.Events.IsUnknown() || config.Events.IsNull() {
+		return
+	}
+	for _, element := range config.Events.Elements() {
+		event, ok := element.(types.String)
+		if !ok || event.IsUnknown() {
+			continue
+		}
+		if !slices.Contains(notificationEvents, event.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("events"),
+				"Invalid Notification Event",
+				fmt.Sprintf("Alerts are sent for one of %q, got: %q", notificationEvents, event.ValueString()),
+			)
+		}
+	}
+}
+
+// Create a new resource. The channel is sent a test notification once it is
+// created, and is deleted again when the notification cannot be delivered,
+// so a misconfigured channel fails the apply instead of dropping alerts.
+func (r *notificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiNotificationChannel
+	err := r.client.do(ctx, http.MethodPost, "/notification-channels", channel, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating notification channel",
+			"Could not create notification channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := strconv.Itoa(created.ID)
+	err = r.client.do(ctx, http.MethodPost, "/notification-channels/"+id+"/test", nil, nil)
+	if err != nil {
+		detail := "Could not deliver a test notification to the channel, so it has been deleted: " + err.Error()
+		if deleteErr := r.client.do(ctx, http.MethodDelete, "/notification-channels/"+id, nil, nil); deleteErr != nil && !isNotFound(deleteErr) {
+			detail = "Could not deliver a test notification to the channel: " + err.Error() +
+				"\n\nThe channel could not be deleted either and must be removed manually, notification channel ID " + id + ": " + deleteErr.Error()
+		}
+		resp.Diagnostics.AddError(
+			"Error Verifying HashiCups Notification Channel",
+			detail,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *notificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state notificationChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channel apiNotificationChannel
+	err := r.client.do(ctx, http.MethodGet, "/notification-channels/"+state.ID.ValueString(), nil, &channel)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups notification channel not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Notification Channel",
+			"Could not read HashiCups notification channel ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(ctx, channel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *notificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notificationChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiNotificationChannel
+	err := r.client.do(ctx, http.MethodPut, "/notification-channels/"+plan.ID.ValueString(), channel, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Notification Channel",
+			"Could not update notification channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *notificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/notification-channels/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Notification Channel Already Deleted",
+			"The notification channel was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Notification Channel",
+			"Could not delete notification channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *notificationChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing notification channel by its ID. The
+// webhook URL of an imported Slack channel is not known until it is
+// configured.
+func (r *notificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m notificationChannelResourceModel) toAPI(ctx context.Context) (apiNotificationChannel, diag.Diagnostics) {
+	channel := apiNotificationChannel{
+		Name:        m.Name.ValueString(),
+		Type:        m.Type.ValueString(),
+		Email:       m.Email.ValueString(),
+		WebhookURL:  m.WebhookURL.ValueString(),
+		PhoneNumber: m.PhoneNumber.ValueString(),
+		Events:      []string{},
+	}
+	diags := m.Events.ElementsAs(ctx, &channel.Events, false)
+
+	return channel, diags
+}
+
+// fromAPI maps an API response body onto the resource model. The webhook
+// URL is kept from the model.
+func (m *notificationChannelResourceModel) fromAPI(ctx context.Context, channel apiNotificationChannel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.Itoa(channel.ID))
+	m.Name = types.StringValue(channel.Name)
+	m.Type = types.StringValue(channel.Type)
+	m.Email = stringValueOrNull(channel.Email)
+	m.PhoneNumber = stringValueOrNull(channel.PhoneNumber)
+	// events is required, so a channel without events has an empty set
+	// rather than a null one.
+	events := channel.Events
+	if events == nil {
+		events = []string{}
+	}
+	m.Events, diags = types.SetValueFrom(ctx, types.StringType, events)
+
+	return diags
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"strings"
+	"time"
+)
+
+// withinOpeningHours reports whether the window from start to end falls
+// inside one of the opening hours. Opening hours are in the cafe's local
+// time, which is taken to be the offset of start. Hours that close at or
+// before they open close after midnight, on the following day.
+func withinOpeningHours(hours []apiOpeningHours, start, end time.Time) bool {
+	startDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+
+	for _, h := range hours {
+		opens, opensErr := time.Parse("15:04", h.Opens)
+		closes, closesErr := time.Parse("15:04", h.Closes)
+		if opensErr != nil || closesErr != nil {
+			continue
+		}
+
+		openFor := closes.Sub(opens)
+		if openFor <= 0 {
+			openFor += 24 * time.Hour
+		}
+
+		// Hours past midnight of the day before may cover the window too.
+		for _, day := range []time.Time{startDay, startDay.AddDate(0, 0, -1)} {
+			if !strings.EqualFold(h.Day, day.Weekday().String()) {
+				continue
+			}
+
+			openAt := day.Add(time.Duration(opens.Hour())*time.Hour + time.Duration(opens.Minute())*time.Minute)
+			if !start.Before(openAt) && !end.After(openAt.Add(openFor)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithinOpeningHours(t *testing.T) {
+	t.Parallel()
+
+	// 2024-06-07 is a Friday.
+	hours := []apiOpeningHours{
+		{Day: "friday", Opens: "07:00", Closes: "12:00"},
+		{Day: "friday", Opens: "14:00", Closes: "02:00"},
+		{Day: "saturday", Opens: "09:00", Closes: "17:00"},
+	}
+
+	testCases := map[string]struct {
+		hours    []apiOpeningHours
+		start    string
+		end      string
+		expected bool
+	}{
+		"inside": {
+			hours:    hours,
+			start:    "2024-06-07T08:00:00Z",
+			end:      "2024-06-07T10:00:00Z",
+			expected: true,
+		},
+		"exact": {
+			hours:    hours,
+			start:    "2024-06-07T07:00:00Z",
+			end:      "2024-06-07T12:00:00Z",
+			expected: true,
+		},
+		"before-opening": {
+			hours: hours,
+			start: "2024-06-07T06:30:00Z",
+			end:   "2024-06-07T08:00:00Z",
+		},
+		"spans-break": {
+			hours: hours,
+			start: "2024-06-07T11:00:00Z",
+			end:   "2024-06-07T15:00:00Z",
+		},
+		"past-midnight": {
+			hours:    hours,
+			start:    "2024-06-07T22:00:00Z",
+			end:      "2024-06-08T01:30:00Z",
+			expected: true,
+		},
+		"after-midnight": {
+			hours:    hours,
+			start:    "2024-06-08T00:30:00Z",
+			end:      "2024-06-08T01:30:00Z",
+			expected: true,
+		},
+		"after-closing-past-midnight": {
+			hours: hours,
+			start: "2024-06-08T01:30:00Z",
+			end:   "2024-06-08T03:00:00Z",
+		},
+		"closed-day": {
+			hours: hours,
+			start: "2024-06-09T10:00:00Z",
+			end:   "2024-06-09T11:00:00Z",
+		},
+		"local-offset": {
+			hours:    hours,
+			start:    "2024-06-08T09:30:00+02:00",
+			end:      "2024-06-08T11:00:00+02:00",
+			expected: true,
+		},
+		"no-hours": {
+			start: "2024-06-07T08:00:00Z",
+			end:   "2024-06-07T10:00:00Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start, err := time.Parse(time.RFC3339, testCase.start)
+			if err != nil {
+				t.Fatal(err)
+			}
+			end, err := time.Parse(time.RFC3339, testCase.end)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := withinOpeningHours(testCase.hours, start, end); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -3,21 +3,28 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"net/http"
 	"strconv"
 	"time"
 
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/inpyu/hashicups-client-go"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &orderResource{}
-	_ resource.ResourceWithConfigure = &orderResource{}
+	_ resource.Resource                = &orderResource{}
+	_ resource.ResourceWithConfigure   = &orderResource{}
+	_ resource.ResourceWithImportState = &orderResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -27,13 +34,14 @@ func NewOrderResource() resource.Resource {
 
 // orderResource is the resource implementation.
 type orderResource struct {
-	client *hashicups.Client
+	client *apiClient
 }
 
 // orderResourceModel maps the resource schema data.
 type orderResourceModel struct {
 	ID          types.String     `tfsdk:"id"`
 	Items       []orderItemModel `tfsdk:"items"`
+	Total       decimal.Value    `tfsdk:"total"`
 	LastUpdated types.String     `tfsdk:"last_updated"`
 }
 
@@ -49,7 +57,7 @@ type orderItemCoffeeModel struct {
 	Name        types.String  `tfsdk:"name"`
 	Teaser      types.String  `tfsdk:"teaser"`
 	Description types.String  `tfsdk:"description"`
-	Price       types.Float64 `tfsdk:"price"`
+	Price       decimal.Value `tfsdk:"price"`
 	Image       types.String  `tfsdk:"image"`
 }
 
@@ -71,6 +79,10 @@ func (r *orderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
+			"total": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Computed:   true,
+			},
 			"items": schema.ListNestedAttribute{
 				Required: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -93,8 +105,9 @@ func (r *orderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 								"description": schema.StringAttribute{
 									Computed: true,
 								},
-								"price": schema.Float64Attribute{
-									Computed: true,
+								"price": schema.StringAttribute{
+									CustomType: decimal.Type{},
+									Computed:   true,
 								},
 								"image": schema.StringAttribute{
 									Computed: true,
@@ -148,12 +161,13 @@ func (r *orderResource) Create(ctx context.Context, req resource.CreateRequest,
 				Name:        types.StringValue(orderItem.Coffee.Name),
 				Teaser:      types.StringValue(orderItem.Coffee.Teaser),
 				Description: types.StringValue(orderItem.Coffee.Description),
-				Price:       types.Float64Value(orderItem.Coffee.Price),
+				Price:       decimal.NewFloat64Value(orderItem.Coffee.Price),
 				Image:       types.StringValue(orderItem.Coffee.Image),
 			},
 			Quantity: types.Int64Value(int64(orderItem.Quantity)),
 		}
 	}
+	plan.Total = orderTotal(order.Items)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Set state to fully populated data
@@ -176,6 +190,11 @@ func (r *orderResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Get refreshed order value from HashiCups
 	order, err := r.client.GetOrder(state.ID.ValueString(), nil)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups order not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading HashiCups Order",
@@ -193,12 +212,13 @@ func (r *orderResource) Read(ctx context.Context, req resource.ReadRequest, resp
 				Name:        types.StringValue(item.Coffee.Name),
 				Teaser:      types.StringValue(item.Coffee.Teaser),
 				Description: types.StringValue(item.Coffee.Description),
-				Price:       types.Float64Value(item.Coffee.Price),
+				Price:       decimal.NewFloat64Value(item.Coffee.Price),
 				Image:       types.StringValue(item.Coffee.Image),
 			},
 			Quantity: types.Int64Value(int64(item.Quantity)),
 		})
 	}
+	state.Total = orderTotal(order.Items)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -258,12 +278,13 @@ func (r *orderResource) Update(ctx context.Context, req resource.UpdateRequest,
 				Name:        types.StringValue(item.Coffee.Name),
 				Teaser:      types.StringValue(item.Coffee.Teaser),
 				Description: types.StringValue(item.Coffee.Description),
-				Price:       types.Float64Value(item.Coffee.Price),
+				Price:       decimal.NewFloat64Value(item.Coffee.Price),
 				Image:       types.StringValue(item.Coffee.Image),
 			},
 			Quantity: types.Int64Value(int64(item.Quantity)),
 		})
 	}
+	plan.Total = orderTotal(order.Items)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -284,6 +305,13 @@ func (r *orderResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	// Delete existing order
 	err := r.client.DeleteOrder(state.ID.ValueString(), nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Order Already Deleted",
+			"The order was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting HashiCups Order",
@@ -299,12 +327,12 @@ func (r *orderResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	client, ok := req.ProviderData.(*hashicups.Client)
+	client, ok := req.ProviderData.(*apiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -312,3 +340,25 @@ func (r *orderResource) Configure(_ context.Context, req resource.ConfigureReque
 
 	r.client = client
 }
+
+// ImportState imports an existing order by its ID.
+func (r *orderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// orderTotal returns the price of an order, summed across its items. The sum
+// is exact, so that for example three coffees at 0.10 total 0.3.
+func orderTotal(items []hashicups.OrderItem) decimal.Value {
+	total := new(big.Rat)
+	for _, item := range items {
+		price, _ := new(big.Rat).SetString(strconv.FormatFloat(item.Coffee.Price, 'f', -1, 64))
+		total.Add(total, price.Mul(price, big.NewRat(int64(item.Quantity), 1)))
+	}
+
+	return decimal.NewValue(decimal.Format(total))
+}
+
+// deleteOrder is the context-aware counterpart of DeleteOrder.
+func (c *apiClient) deleteOrder(ctx context.Context, orderID string) error {
+	return c.do(ctx, http.MethodDelete, "/orders/"+orderID, nil, nil)
+}
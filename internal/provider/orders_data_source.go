@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/datasourcefilter"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &ordersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &ordersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ordersDataSource{}
+)
+
+// NewOrdersDataSource is a helper function to simplify the provider implementation.
+func NewOrdersDataSource() datasource.DataSource {
+	return &ordersDataSource{}
+}
+
+// ordersDataSource is the data source implementation.
+type ordersDataSource struct {
+	client *apiClient
+}
+
+// ordersDataSourceModel maps the data source schema data.
+type ordersDataSourceModel struct {
+	CafeID        types.String             `tfsdk:"cafe_id"`
+	Status        types.String             `tfsdk:"status"`
+	CreatedAfter  types.String             `tfsdk:"created_after"`
+	CreatedBefore types.String             `tfsdk:"created_before"`
+	MaxItems      types.Int64              `tfsdk:"max_items"`
+	PageSize      types.Int64              `tfsdk:"page_size"`
+	SortBy        types.String             `tfsdk:"sort_by"`
+	SortOrder     types.String             `tfsdk:"sort_order"`
+	Filters       []datasourcefilter.Model `tfsdk:"filter"`
+	Orders        []ordersModel            `tfsdk:"orders"`
+}
+
+// ordersModel maps orders schema data.
+type ordersModel struct {
+	ID        types.String      `tfsdk:"id"`
+	CafeID    types.String      `tfsdk:"cafe_id"`
+	Status    types.String      `tfsdk:"status"`
+	CreatedAt types.String      `tfsdk:"created_at"`
+	Items     []ordersItemModel `tfsdk:"items"`
+}
+
+// ordersItemModel maps order items data.
+type ordersItemModel struct {
+	CoffeeID   types.Int64  `tfsdk:"coffee_id"`
+	CoffeeName types.String `tfsdk:"coffee_name"`
+	Quantity   types.Int64  `tfsdk:"quantity"`
+}
+
+// apiListedOrder is the API representation of an order as listed by the
+// orders endpoint, which unlike hashicups.Order includes the cafe the order
+// was placed at, its status and when it was placed.
+type apiListedOrder struct {
+	ID        int                  `json:"id"`
+	CafeID    int                  `json:"cafe_id"`
+	Status    string               `json:"status"`
+	CreatedAt string               `json:"created_at"`
+	Items     []apiListedOrderItem `json:"items"`
+}
+
+// apiListedOrderItem is the API representation of an item of a listed
+// order.
+type apiListedOrderItem struct {
+	Coffee struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"coffee"`
+	Quantity int `json:"quantity"`
+}
+
+// ordersSortFields are the fields the orders can be sorted by.
+var ordersSortFields = sortFields[apiListedOrder]{
+	"id": func(a, b apiListedOrder) int {
+		return cmp.Compare(a.ID, b.ID)
+	},
+	"cafe_id": func(a, b apiListedOrder) int {
+		return cmp.Compare(a.CafeID, b.CafeID)
+	},
+	"status": func(a, b apiListedOrder) int {
+		return cmp.Compare(a.Status, b.Status)
+	},
+	"created_at": func(a, b apiListedOrder) int {
+		return compareTimestamps(a.CreatedAt, b.CreatedAt)
+	},
+}
+
+// Metadata returns the data source type name.
+func (d *ordersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orders"
+}
+
+// Schema defines the schema for the data source.
+func (d *ordersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cafe_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+			},
+			// created_after and created_before limit orders to those placed
+			// in the range, inclusive of created_after.
+			"created_after": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			"created_before": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			"max_items":  maxItemsAttribute(),
+			"page_size":  pageSizeAttribute(),
+			"sort_by":    sortByAttribute(ordersSortFields),
+			"sort_order": sortOrderAttribute(),
+			"orders": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"cafe_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"items": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"coffee_id": schema.Int64Attribute{
+										Computed: true,
+									},
+									"coffee_name": schema.StringAttribute{
+										Computed: true,
+									},
+									"quantity": schema.Int64Attribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		// The orders API filters orders itself, so the filters are sent to
+		// it along with the other arguments.
+		Blocks: map[string]schema.Block{
+			"filter": datasourcefilter.Block("id", "cafe_id", "status"),
+		},
+	}
+}
+
+// ValidateConfig checks that the date range ends after it starts.
+func (d *ordersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var createdAfter, createdBefore types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("created_after"), &createdAfter)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("created_before"), &createdBefore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeWindow(createdAfter, createdBefore, path.Root("created_before"), "Invalid Order Date Range", &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the orders matching the filters.
+// The orders are filtered by the API.
+func (d *ordersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ordersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := datasourcefilter.Query(state.Filters)
+	for name, value := range map[string]types.String{
+		"cafe_id":        state.CafeID,
+		"status":         state.Status,
+		"created_after":  state.CreatedAfter,
+		"created_before": state.CreatedBefore,
+	} {
+		if !value.IsNull() {
+			query.Add(name, value.ValueString())
+		}
+	}
+
+	orders, err := listSorted[apiListedOrder](ctx, d.client, "/orders", query, newListOptions(state.MaxItems, state.PageSize), ordersSortFields, state.SortBy, state.SortOrder, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Orders",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Orders = []ordersModel{}
+	for _, order := range orders {
+		orderState := ordersModel{
+			ID:        types.StringValue(strconv.Itoa(order.ID)),
+			CafeID:    types.StringValue(strconv.Itoa(order.CafeID)),
+			Status:    types.StringValue(order.Status),
+			CreatedAt: types.StringValue(order.CreatedAt),
+			Items:     []ordersItemModel{},
+		}
+
+		for _, item := range order.Items {
+			orderState.Items = append(orderState.Items, ordersItemModel{
+				CoffeeID:   types.Int64Value(int64(item.Coffee.ID)),
+				CoffeeName: types.StringValue(item.Coffee.Name),
+				Quantity:   types.Int64Value(int64(item.Quantity)),
+			})
+		}
+
+		state.Orders = append(state.Orders, orderState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ordersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
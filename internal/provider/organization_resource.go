@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &organizationResource{}
+	_ resource.ResourceWithConfigure   = &organizationResource{}
+	_ resource.ResourceWithImportState = &organizationResource{}
+)
+
+// organizationPlans are the subscription plans an organization can be on.
+var organizationPlans = []string{"free", "team", "enterprise"}
+
+// NewOrganizationResource is a helper function to simplify the provider implementation.
+func NewOrganizationResource() resource.Resource {
+	return &organizationResource{}
+}
+
+// organizationResource is the resource implementation.
+type organizationResource struct {
+	client *apiClient
+}
+
+// organizationResourceModel maps the resource schema data.
+type organizationResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	BillingEmail types.String `tfsdk:"billing_email"`
+	Plan         types.String `tfsdk:"plan"`
+}
+
+// apiOrganization is the API representation of an organization, which owns
+// cafes through their org_id.
+type apiOrganization struct {
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name"`
+	BillingEmail string `json:"billing_email"`
+	Plan         string `json:"plan"`
+}
+
+// Metadata returns the resource type name.
+func (r *organizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"billing_email": schema.StringAttribute{
+				Required: true,
+			},
+			"plan": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("free"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationPlans...),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization apiOrganization
+	err := r.client.do(ctx, http.MethodPost, "/organizations", plan.toAPI(), &organization)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization",
+			"Could not create organization, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(organization)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization apiOrganization
+	err := r.client.do(ctx, http.MethodGet, "/organizations/"+state.ID.ValueString(), nil, &organization)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups organization not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Organization",
+			"Could not read HashiCups organization ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(organization)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan organizationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization apiOrganization
+	err := r.client.do(ctx, http.MethodPut, "/organizations/"+plan.ID.ValueString(), plan.toAPI(), &organization)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Organization",
+			"Could not update organization, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(organization)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/organizations/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Organization Already Deleted",
+			"The organization was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Organization",
+			"Could not delete organization, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing organization by its ID.
+func (r *organizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m organizationResourceModel) toAPI() apiOrganization {
+	return apiOrganization{
+		Name:         m.Name.ValueString(),
+		BillingEmail: m.BillingEmail.ValueString(),
+		Plan:         m.Plan.ValueString(),
+	}
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *organizationResourceModel) fromAPI(organization apiOrganization) {
+	m.ID = types.StringValue(strconv.Itoa(organization.ID))
+	m.Name = types.StringValue(organization.Name)
+	m.BillingEmail = types.StringValue(organization.BillingEmail)
+	m.Plan = types.StringValue(organization.Plan)
+}
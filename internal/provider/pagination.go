@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultPageSize is the number of items requested per page from list
+// endpoints when a data source does not set page_size.
+const defaultPageSize = 100
+
+// listOptions bound the listing of a list endpoint. A zero PageSize selects
+// defaultPageSize, and a zero MaxItems lists every item.
+type listOptions struct {
+	PageSize int
+	MaxItems int
+}
+
+// listAll lists the items of a paginated list endpoint, requesting pages of
+// opts.PageSize items with the page and page_size query parameters until a
+// page comes back short. A page longer than requested, or a page identical to
+// the one before it, means the endpoint does not paginate and returned every
+// item at once. Items for which keep returns false are skipped, and listing
+// stops once opts.MaxItems items are kept. keep may be nil to keep every
+// item.
+func listAll[T any](ctx context.Context, c *apiClient, path string, query url.Values, opts listOptions, keep func(T) bool) ([]T, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	query = maps.Clone(query)
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("page_size", strconv.Itoa(pageSize))
+
+	var items []T
+	var previous json.RawMessage
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		var body json.RawMessage
+		if err := c.do(ctx, http.MethodGet, path+"?"+query.Encode(), nil, &body); err != nil {
+			return nil, err
+		}
+
+		// An endpoint that ignores page returns the same items on every
+		// page, which would otherwise never come back short.
+		if page > 1 && bytes.Equal(body, previous) {
+			return items, nil
+		}
+		previous = body
+
+		var pageItems []T
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &pageItems); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, item := range pageItems {
+			if keep != nil && !keep(item) {
+				continue
+			}
+
+			items = append(items, item)
+			if opts.MaxItems > 0 && len(items) >= opts.MaxItems {
+				return items, nil
+			}
+		}
+
+		if len(pageItems) != pageSize {
+			return items, nil
+		}
+	}
+}
+
+// maxItemsAttribute returns the max_items argument of list data sources.
+func maxItemsAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: "The maximum number of results to return. All results are returned when not set.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// pageSizeAttribute returns the page_size argument of list data sources.
+func pageSizeAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: "The number of results to request from the API at a time. Defaults to " + strconv.Itoa(defaultPageSize) + ".",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// newListOptions returns the listOptions for the max_items and page_size
+// arguments of a list data source.
+func newListOptions(maxItems, pageSize types.Int64) listOptions {
+	return listOptions{
+		MaxItems: int(maxItems.ValueInt64()),
+		PageSize: int(pageSize.ValueInt64()),
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/inpyu/hashicups-client-go"
+)
+
+func TestListAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		total         int
+		paginates     bool
+		opts          listOptions
+		keep          func(int) bool
+		expected      []int
+		expectedPages int
+	}{
+		"single-page": {
+			total:         3,
+			paginates:     true,
+			opts:          listOptions{PageSize: 5},
+			expected:      []int{1, 2, 3},
+			expectedPages: 1,
+		},
+		"multiple-pages": {
+			total:         7,
+			paginates:     true,
+			opts:          listOptions{PageSize: 3},
+			expected:      []int{1, 2, 3, 4, 5, 6, 7},
+			expectedPages: 3,
+		},
+		"exact-pages": {
+			total:         6,
+			paginates:     true,
+			opts:          listOptions{PageSize: 3},
+			expected:      []int{1, 2, 3, 4, 5, 6},
+			expectedPages: 3,
+		},
+		"max-items": {
+			total:         7,
+			paginates:     true,
+			opts:          listOptions{PageSize: 3, MaxItems: 4},
+			expected:      []int{1, 2, 3, 4},
+			expectedPages: 2,
+		},
+		"max-items-after-keep": {
+			total:         10,
+			paginates:     true,
+			opts:          listOptions{PageSize: 3, MaxItems: 3},
+			keep:          func(n int) bool { return n%2 == 0 },
+			expected:      []int{2, 4, 6},
+			expectedPages: 2,
+		},
+		"not-paginated": {
+			total:         7,
+			opts:          listOptions{PageSize: 3},
+			expected:      []int{1, 2, 3, 4, 5, 6, 7},
+			expectedPages: 1,
+		},
+		"not-paginated-exact-page": {
+			total:         3,
+			opts:          listOptions{PageSize: 3},
+			expected:      []int{1, 2, 3},
+			expectedPages: 2,
+		},
+		"not-paginated-empty": {
+			total:         0,
+			opts:          listOptions{PageSize: 3},
+			expected:      nil,
+			expectedPages: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var pages int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				pages++
+
+				items := []int{}
+				for n := 1; n <= testCase.total; n++ {
+					items = append(items, n)
+				}
+
+				if testCase.paginates {
+					page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+					pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+					start := min((page-1)*pageSize, len(items))
+					items = items[start:min(start+pageSize, len(items))]
+				}
+
+				_ = json.NewEncoder(w).Encode(items)
+			}))
+			defer server.Close()
+
+			client := &apiClient{Client: &hashicups.Client{HostURL: server.URL, HTTPClient: server.Client()}}
+
+			got, err := listAll(context.Background(), client, "/items", nil, testCase.opts, testCase.keep)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+			if pages != testCase.expectedPages {
+				t.Errorf("expected %d pages to be requested, got %d", testCase.expectedPages, pages)
+			}
+		})
+	}
+}
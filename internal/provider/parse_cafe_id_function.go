@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &parseCafeIDFunction{}
+)
+
+// NewParseCafeIDFunction is a helper function to simplify the provider implementation.
+func NewParseCafeIDFunction() function.Function {
+	return &parseCafeIDFunction{}
+}
+
+// parseCafeIDFunction is the function implementation.
+type parseCafeIDFunction struct{}
+
+// parseCafeIDFunctionResult maps the function's object result. ChildType and
+// ChildID are null when the ID identifies the cafe itself.
+type parseCafeIDFunctionResult struct {
+	CafeID    types.String `tfsdk:"cafe_id"`
+	ChildType types.String `tfsdk:"child_type"`
+	ChildID   types.String `tfsdk:"child_id"`
+}
+
+// Metadata returns the function name.
+func (f *parseCafeIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_cafe_id"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *parseCafeIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a composite cafe ID",
+		Description: "Splits an ID of the form cafe/<cafe_id> or cafe/<cafe_id>/<type>/<id> into its components.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The composite ID to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"cafe_id":    types.StringType,
+				"child_type": types.StringType,
+				"child_id":   types.StringType,
+			},
+		},
+	}
+}
+
+// Run parses the composite ID.
+func (f *parseCafeIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &s))
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := parseCafeID(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid cafe ID: "+err.Error())
+		return
+	}
+
+	result := parseCafeIDFunctionResult{
+		CafeID:    types.StringValue(id.CafeID),
+		ChildType: types.StringNull(),
+		ChildID:   types.StringNull(),
+	}
+	if id.ChildType != "" {
+		result.ChildType = types.StringValue(id.ChildType)
+		result.ChildID = types.StringValue(id.ChildID)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
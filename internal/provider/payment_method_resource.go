@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &paymentMethodResource{}
+	_ resource.ResourceWithConfigure      = &paymentMethodResource{}
+	_ resource.ResourceWithValidateConfig = &paymentMethodResource{}
+	_ resource.ResourceWithImportState    = &paymentMethodResource{}
+)
+
+// paymentMethodTypes are the types of payment a cafe can accept.
+var paymentMethodTypes = []string{"card", "cash", "apple_pay", "google_pay"}
+
+// NewPaymentMethodResource is a helper function to simplify the provider implementation.
+func NewPaymentMethodResource() resource.Resource {
+	return &paymentMethodResource{}
+}
+
+// paymentMethodResource is the resource implementation.
+type paymentMethodResource struct {
+	client *apiClient
+}
+
+// paymentMethodResourceModel maps the resource schema data.
+type paymentMethodResourceModel struct {
+	ID        types.String           `tfsdk:"id"`
+	CafeID    types.String           `tfsdk:"cafe_id"`
+	Type      types.String           `tfsdk:"type"`
+	Enabled   types.Bool             `tfsdk:"enabled"`
+	Processor *paymentProcessorModel `tfsdk:"processor"`
+}
+
+// paymentProcessorModel maps the processor that handles the payments of a
+// payment method.
+type paymentProcessorModel struct {
+	Name       types.String `tfsdk:"name"`
+	MerchantID types.String `tfsdk:"merchant_id"`
+	APIKey     types.String `tfsdk:"api_key"`
+}
+
+// apiPaymentMethod is the API representation of a payment method accepted
+// by a cafe.
+type apiPaymentMethod struct {
+	ID        int                  `json:"id,omitempty"`
+	CafeID    int                  `json:"cafe_id"`
+	Type      string               `json:"type"`
+	Enabled   bool                 `json:"enabled"`
+	Processor *apiPaymentProcessor `json:"processor,omitempty"`
+}
+
+// apiPaymentProcessor is the API representation of a payment processor.
+// APIKey is accepted by the API on create and update but never returned.
+type apiPaymentProcessor struct {
+	Name       string `json:"name"`
+	MerchantID string `json:"merchant_id"`
+	APIKey     string `json:"api_key,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *paymentMethodResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_method"
+}
+
+// Schema defines the schema for the resource.
+func (r *paymentMethodResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(paymentMethodTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			// Every type of payment but cash goes through a processor.
+			"processor": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"merchant_id": schema.StringAttribute{
+						Required: true,
+					},
+					// The API never returns the API key, so it is kept as
+					// configured.
+					"api_key": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that payments other than cash have a processor, and
+// that cash payments have none.
+func (r *paymentMethodResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var methodType types.String
+	var processor types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &methodType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("processor"), &processor)...)
+	if resp.Diagnostics.HasError() || methodType.IsUnknown() || processor.IsUnknown() {
+		return
+	}
+
+	switch {
+	case methodType.ValueString() == "cash" && !processor.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("processor"),
+			"Unexpected Payment Processor",
+			"Cash payments are not handled by a processor, so processor must not be set.",
+		)
+	case methodType.ValueString() != "cash" && processor.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("processor"),
+			"Missing Payment Processor",
+			"Payments of type "+methodType.ValueString()+" are handled by a processor, so processor must be set.",
+		)
+	}
+}
+
+// Create a new resource.
+func (r *paymentMethodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan paymentMethodResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiPaymentMethod
+	err := r.client.do(ctx, http.MethodPost, "/payment-methods", method, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating payment method",
+			"Could not create payment method, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *paymentMethodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state paymentMethodResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var method apiPaymentMethod
+	err := r.client.do(ctx, http.MethodGet, "/payment-methods/"+state.ID.ValueString(), nil, &method)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups payment method not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Payment Method",
+			"Could not read HashiCups payment method ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(method)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *paymentMethodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan paymentMethodResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiPaymentMethod
+	err := r.client.do(ctx, http.MethodPut, "/payment-methods/"+plan.ID.ValueString(), method, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Payment Method",
+			"Could not update payment method, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *paymentMethodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state paymentMethodResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/payment-methods/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Payment Method Already Deleted",
+			"The payment method was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Payment Method",
+			"Could not delete payment method, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *paymentMethodResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing payment method by its ID.
+func (r *paymentMethodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m paymentMethodResourceModel) toAPI() (apiPaymentMethod, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	method := apiPaymentMethod{
+		CafeID:  parseObjectID(path.Root("cafe_id"), m.CafeID.ValueString(), &diags),
+		Type:    m.Type.ValueString(),
+		Enabled: m.Enabled.ValueBool(),
+	}
+	if m.Processor != nil {
+		method.Processor = &apiPaymentProcessor{
+			Name:       m.Processor.Name.ValueString(),
+			MerchantID: m.Processor.MerchantID.ValueString(),
+			APIKey:     m.Processor.APIKey.ValueString(),
+		}
+	}
+
+	return method, diags
+}
+
+// fromAPI maps an API response body onto the resource model. The processor's
+// API key is kept from the model, and is null for imported payment methods.
+func (m *paymentMethodResourceModel) fromAPI(method apiPaymentMethod) {
+	m.ID = types.StringValue(strconv.Itoa(method.ID))
+	m.CafeID = types.StringValue(strconv.Itoa(method.CafeID))
+	m.Type = types.StringValue(method.Type)
+	m.Enabled = types.BoolValue(method.Enabled)
+
+	apiKey := types.StringNull()
+	if m.Processor != nil {
+		apiKey = m.Processor.APIKey
+	}
+	m.Processor = nil
+	if processor := method.Processor; processor != nil {
+		m.Processor = &paymentProcessorModel{
+			Name:       types.StringValue(processor.Name),
+			MerchantID: types.StringValue(processor.MerchantID),
+			APIKey:     apiKey,
+		}
+	}
+}
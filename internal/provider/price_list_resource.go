@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &priceListResource{}
+	_ resource.ResourceWithConfigure      = &priceListResource{}
+	_ resource.ResourceWithValidateConfig = &priceListResource{}
+	_ resource.ResourceWithImportState    = &priceListResource{}
+)
+
+// NewPriceListResource is a helper function to simplify the provider implementation.
+func NewPriceListResource() resource.Resource {
+	return &priceListResource{}
+}
+
+// priceListResource is the resource implementation.
+type priceListResource struct {
+	client *apiClient
+}
+
+// priceListResourceModel maps the resource schema data.
+type priceListResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Prices types.Map    `tfsdk:"prices"`
+}
+
+// apiPriceList is the API representation of a named price list, which cafes
+// refer to by its ID. Prices maps coffee IDs to prices.
+type apiPriceList struct {
+	ID     int                    `json:"id,omitempty"`
+	Name   string                 `json:"name"`
+	Prices map[string]json.Number `json:"prices"`
+}
+
+// Metadata returns the resource type name.
+func (r *priceListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price_list"
+}
+
+// Schema defines the schema for the resource.
+func (r *priceListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			// prices maps coffee IDs to the prices of the coffees at the
+			// cafes using the price list.
+			"prices": schema.MapAttribute{
+				ElementType: decimal.Type{},
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the prices are keyed by coffee IDs and are not
+// negative.
+func (r *priceListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var pricesValue types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("prices"), &pricesValue)...)
+	if resp.Diagnostics.HasError() || pricesValue.IsNull() || pricesValue.IsUnknown() {
+		return
+	}
+
+	var prices map[string]decimal.Value
+	resp.Diagnostics.Append(pricesValue.ElementsAs(ctx, &prices, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for coffeeID, price := range prices {
+		parseObjectID(path.Root("prices").AtMapKey(coffeeID), coffeeID, &resp.Diagnostics)
+
+		if p, ok := knownDecimal(price); ok && p.Sign() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prices").AtMapKey(coffeeID),
+				"Invalid Price",
+				"The price of coffee ID "+coffeeID+" must not be negative, got: "+price.ValueString(),
+			)
+		}
+	}
+}
+
+// Create a new resource.
+func (r *priceListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan priceListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priceList apiPriceList
+	err := r.client.do(ctx, http.MethodPost, "/price-lists", body, &priceList)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating price list",
+			"Could not create price list, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(priceList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *priceListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state priceListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priceList apiPriceList
+	err := r.client.do(ctx, http.MethodGet, "/price-lists/"+state.ID.ValueString(), nil, &priceList)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups price list not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Price List",
+			"Could not read HashiCups price list ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(priceList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *priceListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan priceListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priceList apiPriceList
+	err := r.client.do(ctx, http.MethodPut, "/price-lists/"+plan.ID.ValueString(), body, &priceList)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Price List",
+			"Could not update price list, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(priceList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *priceListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state priceListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/price-lists/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Price List Already Deleted",
+			"The price list was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Price List",
+			"Could not delete price list, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *priceListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing price list by its ID.
+func (r *priceListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m priceListResourceModel) toAPI(ctx context.Context) (apiPriceList, diag.Diagnostics) {
+	priceList := apiPriceList{
+		Name:   m.Name.ValueString(),
+		Prices: map[string]json.Number{},
+	}
+
+	var prices map[string]decimal.Value
+	diags := m.Prices.ElementsAs(ctx, &prices, false)
+	for coffeeID, price := range prices {
+		priceList.Prices[coffeeID] = json.Number(price.ValueString())
+	}
+
+	return priceList, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *priceListResourceModel) fromAPI(priceList apiPriceList) diag.Diagnostics {
+	m.ID = types.StringValue(strconv.Itoa(priceList.ID))
+	m.Name = types.StringValue(priceList.Name)
+
+	prices := make(map[string]attr.Value, len(priceList.Prices))
+	for coffeeID, price := range priceList.Prices {
+		prices[coffeeID] = decimal.NewValue(price.String())
+	}
+
+	var diags diag.Diagnostics
+	m.Prices, diags = types.MapValue(decimal.Type{}, prices)
+
+	return diags
+}
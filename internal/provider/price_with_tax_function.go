@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &priceWithTaxFunction{}
+)
+
+// NewPriceWithTaxFunction is a helper function to simplify the provider implementation.
+func NewPriceWithTaxFunction() function.Function {
+	return &priceWithTaxFunction{}
+}
+
+// priceWithTaxFunction is the function implementation.
+type priceWithTaxFunction struct{}
+
+// Metadata returns the function name.
+func (f *priceWithTaxFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "price_with_tax"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *priceWithTaxFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Add tax to a price",
+		Description: "Returns the tax-inclusive price as an exact decimal string, in the same form as the price attributes of the provider. " +
+			"The price and tax rate are decimal strings and the result is not rounded.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "price",
+				Description: "The price before tax, such as \"3.99\".",
+			},
+			function.StringParameter{
+				Name:        "tax_rate",
+				Description: "The tax rate as a percentage, such as \"8.5\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run adds the tax to the price.
+func (f *priceWithTaxFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var price, taxRate string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &price, &taxRate))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := decimal.Parse(price)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid price: "+err.Error())
+		return
+	}
+
+	r, err := decimal.Parse(taxRate)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid tax rate: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, decimal.Format(priceWithTax(p, r))))
+}
+
+// priceWithTax returns price increased by taxRate percent.
+func priceWithTax(price, taxRate *big.Rat) *big.Rat {
+	factor := new(big.Rat).Add(big.NewRat(1, 1), new(big.Rat).Quo(taxRate, big.NewRat(100, 1)))
+
+	return factor.Mul(factor, price)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+)
+
+func TestPriceWithTax(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		price    string
+		taxRate  string
+		expected string
+	}{
+		"whole-percentage": {
+			price:    "4.00",
+			taxRate:  "10",
+			expected: "4.4",
+		},
+		"fractional-percentage": {
+			price:    "3.99",
+			taxRate:  "8.5",
+			expected: "4.32915",
+		},
+		"float-imprecise": {
+			price:    "0.1",
+			taxRate:  "20",
+			expected: "0.12",
+		},
+		"zero-rate": {
+			price:    "2.50",
+			taxRate:  "0",
+			expected: "2.5",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			price, err := decimal.Parse(testCase.price)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			taxRate, err := decimal.Parse(testCase.taxRate)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := decimal.Format(priceWithTax(price, taxRate))
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
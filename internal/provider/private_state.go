@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// etagPrivateKey is the private state key holding the ETag of a resource's
+// object as last read by Terraform. It is sent as If-Match on update and
+// delete so that changes made outside of Terraform in the meantime are not
+// overwritten.
+const etagPrivateKey = "etag"
+
+// privateStateGetter is implemented by the Private field of resource requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is implemented by the Private field of resource
+// responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getPrivateString returns the string stored under key in the resource's
+// private state, or an empty string when the key is not set.
+func getPrivateString(ctx context.Context, private privateStateGetter, key string) (string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, key)
+	if diags.HasError() || len(value) == 0 {
+		return "", diags
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		diags.AddError(
+			"Invalid Resource Private State",
+			"Could not decode private state key "+key+": "+err.Error(),
+		)
+		return "", diags
+	}
+
+	return s, diags
+}
+
+// setPrivateString stores value under key in the resource's private state.
+// Private state values must be JSON, so the string is stored JSON encoded.
+// An empty value removes the key.
+func setPrivateString(ctx context.Context, private privateStateSetter, key, value string) diag.Diagnostics {
+	if value == "" {
+		return private.SetKey(ctx, key, nil)
+	}
+
+	rb, err := json.Marshal(value)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Invalid Resource Private State",
+			"Could not encode private state key "+key+": "+err.Error(),
+		)
+		return diags
+	}
+
+	return private.SetKey(ctx, key, rb)
+}
@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/validators/configvalidator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &promotionResource{}
+	_ resource.ResourceWithConfigure        = &promotionResource{}
+	_ resource.ResourceWithConfigValidators = &promotionResource{}
+	_ resource.ResourceWithValidateConfig   = &promotionResource{}
+	_ resource.ResourceWithImportState      = &promotionResource{}
+)
+
+// NewPromotionResource is a helper function to simplify the provider implementation.
+func NewPromotionResource() resource.Resource {
+	return &promotionResource{}
+}
+
+// promotionResource is the resource implementation.
+type promotionResource struct {
+	client *apiClient
+}
+
+// promotionResourceModel maps the resource schema data.
+type promotionResourceModel struct {
+	ID         types.String  `tfsdk:"id"`
+	Code       types.String  `tfsdk:"code"`
+	PercentOff decimal.Value `tfsdk:"percent_off"`
+	AmountOff  decimal.Value `tfsdk:"amount_off"`
+	StartsAt   types.String  `tfsdk:"starts_at"`
+	EndsAt     types.String  `tfsdk:"ends_at"`
+	CafeIDs    types.Set     `tfsdk:"cafe_ids"`
+}
+
+// apiPromotion is the API representation of a promotion. Exactly one of
+// PercentOff and AmountOff is set. A promotion without cafe IDs applies to
+// all cafes.
+type apiPromotion struct {
+	ID         int          `json:"id,omitempty"`
+	Code       string       `json:"code"`
+	PercentOff *json.Number `json:"percent_off,omitempty"`
+	AmountOff  *json.Number `json:"amount_off,omitempty"`
+	StartsAt   string       `json:"starts_at,omitempty"`
+	EndsAt     string       `json:"ends_at,omitempty"`
+	CafeIDs    []int        `json:"cafe_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *promotionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_promotion"
+}
+
+// Schema defines the schema for the resource.
+func (r *promotionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"code": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"percent_off": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Optional:   true,
+			},
+			"amount_off": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Optional:   true,
+			},
+			"starts_at": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			// The promotion applies to all cafes when cafe_ids is null.
+			"cafe_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires the discount to be either a percentage or an
+// amount.
+func (r *promotionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		configvalidator.ExactlyOneOf(
+			path.MatchRoot("percent_off"),
+			path.MatchRoot("amount_off"),
+		),
+	}
+}
+
+// ValidateConfig checks that the discount is positive, that a percentage
+// discount is at most 100 percent, and that the promotion ends after it
+// starts.
+func (r *promotionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config promotionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if percentOff, ok := knownDecimal(config.PercentOff); ok {
+		if percentOff.Sign() <= 0 || percentOff.Cmp(big.NewRat(100, 1)) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("percent_off"),
+				"Invalid Promotion Discount",
+				"percent_off must be greater than 0 and at most 100, got: "+config.PercentOff.ValueString(),
+			)
+		}
+	}
+
+	if amountOff, ok := knownDecimal(config.AmountOff); ok && amountOff.Sign() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("amount_off"),
+			"Invalid Promotion Discount",
+			"amount_off must be greater than 0, got: "+config.AmountOff.ValueString(),
+		)
+	}
+
+	validateTimeWindow(config.StartsAt, config.EndsAt, path.Root("ends_at"), "Invalid Promotion Period", &resp.Diagnostics)
+
+	if !config.CafeIDs.IsUnknown() && !config.CafeIDs.IsNull() && len(config.CafeIDs.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cafe_ids"),
+			"Missing Promotion Cafes",
+			"cafe_ids must contain at least one cafe ID. Leave it unset to apply the promotion to all cafes.",
+		)
+	}
+}
+
+// knownDecimal returns v as a number, and whether it is known, not null and
+// a valid decimal. Invalid decimals are reported by the decimal type itself.
+func knownDecimal(v decimal.Value) (*big.Rat, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, false
+	}
+
+	r, err := decimal.Parse(v.ValueString())
+
+	return r, err == nil
+}
+
+// Create a new resource.
+func (r *promotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan promotionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promotion, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiPromotion
+	err := r.client.do(ctx, http.MethodPost, "/promotions", promotion, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating promotion",
+			"Could not create promotion, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *promotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state promotionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var promotion apiPromotion
+	err := r.client.do(ctx, http.MethodGet, "/promotions/"+state.ID.ValueString(), nil, &promotion)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups promotion not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Promotion",
+			"Could not read HashiCups promotion ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(ctx, promotion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *promotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan promotionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promotion, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiPromotion
+	err := r.client.do(ctx, http.MethodPut, "/promotions/"+plan.ID.ValueString(), promotion, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Promotion",
+			"Could not update promotion, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *promotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state promotionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/promotions/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Promotion Already Deleted",
+			"The promotion was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Promotion",
+			"Could not delete promotion, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *promotionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing promotion by its ID.
+func (r *promotionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m promotionResourceModel) toAPI(ctx context.Context) (apiPromotion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	promotion := apiPromotion{
+		Code:     m.Code.ValueString(),
+		StartsAt: m.StartsAt.ValueString(),
+		EndsAt:   m.EndsAt.ValueString(),
+		CafeIDs:  []int{},
+	}
+	if !m.PercentOff.IsNull() {
+		percentOff := json.Number(m.PercentOff.ValueString())
+		promotion.PercentOff = &percentOff
+	}
+	if !m.AmountOff.IsNull() {
+		amountOff := json.Number(m.AmountOff.ValueString())
+		promotion.AmountOff = &amountOff
+	}
+
+	var cafeIDs []string
+	diags.Append(m.CafeIDs.ElementsAs(ctx, &cafeIDs, false)...)
+	for _, cafeID := range cafeIDs {
+		promotion.CafeIDs = append(promotion.CafeIDs, parseObjectID(path.Root("cafe_ids"), cafeID, &diags))
+	}
+
+	return promotion, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *promotionResourceModel) fromAPI(ctx context.Context, promotion apiPromotion) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.Itoa(promotion.ID))
+	m.Code = types.StringValue(promotion.Code)
+	m.PercentOff = decimal.NewNull()
+	if promotion.PercentOff != nil {
+		m.PercentOff = decimal.NewValue(promotion.PercentOff.String())
+	}
+	m.AmountOff = decimal.NewNull()
+	if promotion.AmountOff != nil {
+		m.AmountOff = decimal.NewValue(promotion.AmountOff.String())
+	}
+	m.StartsAt = types.StringNull()
+	if promotion.StartsAt != "" {
+		m.StartsAt = types.StringValue(promotion.StartsAt)
+	}
+	m.EndsAt = types.StringNull()
+	if promotion.EndsAt != "" {
+		m.EndsAt = types.StringValue(promotion.EndsAt)
+	}
+
+	m.CafeIDs = types.SetNull(types.StringType)
+	if len(promotion.CafeIDs) > 0 {
+		cafeIDs := make([]string, 0, len(promotion.CafeIDs))
+		for _, cafeID := range promotion.CafeIDs {
+			cafeIDs = append(cafeIDs, strconv.Itoa(cafeID))
+		}
+
+		var d diag.Diagnostics
+		m.CafeIDs, d = types.SetValueFrom(ctx, types.StringType, cafeIDs)
+		diags.Append(d...)
+	}
+
+	return diags
+}
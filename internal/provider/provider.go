@@ -2,21 +2,33 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"strconv"
+	"time"
+
+	"terraform-provider-inpyu-ossca/internal/auth"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/inpyu/hashicups-client-go"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &hashicupsProvider{}
+	_ provider.Provider                       = &hashicupsProvider{}
+	_ provider.ProviderWithFunctions          = &hashicupsProvider{}
+	_ provider.ProviderWithEphemeralResources = &hashicupsProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -30,9 +42,32 @@ func New(version string) func() provider.Provider {
 
 // hashicupsProviderModel maps provider schema data to a Go type.
 type hashicupsProviderModel struct {
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Host         types.String `tfsdk:"host"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Token        types.String `tfsdk:"token"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	TokenURL     types.String `tfsdk:"token_url"`
+	Scopes       types.List   `tfsdk:"scopes"`
+
+	ClientCertPEM  types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM   types.String `tfsdk:"client_key_pem"`
+	ClientCertFile types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile  types.String `tfsdk:"client_key_file"`
+	CACertPEM      types.String `tfsdk:"ca_cert_pem"`
+	CACertFile     types.String `tfsdk:"ca_cert_file"`
+	Insecure       types.Bool   `tfsdk:"insecure"`
+	ProxyURL       types.String `tfsdk:"proxy_url"`
+
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryMaxBackoff types.String `tfsdk:"retry_max_backoff"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+
+	CircuitBreakerThreshold types.Int64  `tfsdk:"circuit_breaker_threshold"`
+	CircuitBreakerCooldown  types.String `tfsdk:"circuit_breaker_cooldown"`
+
+	DefaultTags types.Object `tfsdk:"default_tags"`
 }
 
 // hashicupsProvider is the provider implementation.
@@ -41,6 +76,11 @@ type hashicupsProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// wrapTransport, when set, wraps the HTTP transport that carries every
+	// request of the provider. Acceptance tests use it to record and replay
+	// HashiCups API traffic.
+	wrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
 // Metadata returns the provider type name.
@@ -63,6 +103,76 @@ func (p *hashicupsProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:  true,
 				Sensitive: true,
 			},
+			"token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"client_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_url": schema.StringAttribute{
+				Optional: true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"insecure": schema.BoolAttribute{
+				Optional: true,
+			},
+			// The proxy URL may embed the proxy's credentials.
+			"proxy_url": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional: true,
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				Optional: true,
+			},
+			"circuit_breaker_cooldown": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -78,6 +188,17 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	// When the configuration depends on values that are only known after
+	// apply, such as another resource's outputs, Terraform can defer the
+	// provider's resources and data sources to a later plan instead.
+	if req.ClientCapabilities.DeferralAllowed && !req.Config.Raw.IsFullyKnown() {
+		tflog.Info(ctx, "Deferring HashiCups client configuration until its configuration is known")
+		resp.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+		return
+	}
+
 	// If practitioner provided a configuration value for any of the
 	// attributes, it must be a known value.
 
@@ -108,6 +229,100 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.Token.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Unknown HashiCups API Token",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the HashiCups API token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_TOKEN environment variable.",
+		)
+	}
+
+	if config.ClientID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Unknown HashiCups OAuth2 Client ID",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the OAuth2 client ID. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_CLIENT_ID environment variable.",
+		)
+	}
+
+	if config.ClientSecret.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Unknown HashiCups OAuth2 Client Secret",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the OAuth2 client secret. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_CLIENT_SECRET environment variable.",
+		)
+	}
+
+	if config.TokenURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_url"),
+			"Unknown HashiCups OAuth2 Token URL",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the OAuth2 token URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_TOKEN_URL environment variable.",
+		)
+	}
+
+	if config.Scopes.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scopes"),
+			"Unknown HashiCups OAuth2 Scopes",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the OAuth2 scopes. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ClientCertPEM.IsUnknown() || config.ClientKeyPEM.IsUnknown() ||
+		config.ClientCertFile.IsUnknown() || config.ClientKeyFile.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown HashiCups Client Certificate",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the client certificate or key. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.CACertPEM.IsUnknown() || config.CACertFile.IsUnknown() || config.Insecure.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown HashiCups TLS Configuration",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the CA bundle or insecure mode. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Unknown HashiCups Proxy URL",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the proxy URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_PROXY_URL environment variable.",
+		)
+	}
+
+	if config.MaxRetries.IsUnknown() || config.RetryMaxBackoff.IsUnknown() || config.RequestTimeout.IsUnknown() ||
+		config.CircuitBreakerThreshold.IsUnknown() || config.CircuitBreakerCooldown.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown HashiCups Request Configuration",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the retry, timeout or circuit breaker settings. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	var defaultTags defaultTagsModel
+	if !config.DefaultTags.IsNull() && !config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.Append(config.DefaultTags.As(ctx, &defaultTags, basetypes.ObjectAsOptions{})...)
+	}
+
+	if config.DefaultTags.IsUnknown() || defaultTags.Tags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unknown HashiCups Default Tags",
+			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the default tags. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -118,6 +333,21 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 	host := os.Getenv("HASHICUPS_HOST")
 	username := os.Getenv("HASHICUPS_USERNAME")
 	password := os.Getenv("HASHICUPS_PASSWORD")
+	token := os.Getenv("HASHICUPS_TOKEN")
+	clientID := os.Getenv("HASHICUPS_CLIENT_ID")
+	clientSecret := os.Getenv("HASHICUPS_CLIENT_SECRET")
+	tokenURL := os.Getenv("HASHICUPS_TOKEN_URL")
+	var scopes []string
+	clientCertFile := os.Getenv("HASHICUPS_CLIENT_CERT_FILE")
+	clientKeyFile := os.Getenv("HASHICUPS_CLIENT_KEY_FILE")
+	caCertFile := os.Getenv("HASHICUPS_CA_CERT_FILE")
+	insecure := false
+	proxyURL := os.Getenv("HASHICUPS_PROXY_URL")
+	maxRetries := defaultMaxRetries
+	retryMaxBackoff := defaultRetryMaxBackoff
+	requestTimeout := defaultRequestTimeout
+	circuitBreakerThreshold := defaultCircuitBreakerThreshold
+	circuitBreakerCooldown := defaultCircuitBreakerCooldown
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
@@ -131,6 +361,117 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 		password = config.Password.ValueString()
 	}
 
+	if !config.Token.IsNull() {
+		token = config.Token.ValueString()
+	}
+
+	if !config.ClientID.IsNull() {
+		clientID = config.ClientID.ValueString()
+	}
+
+	if !config.ClientSecret.IsNull() {
+		clientSecret = config.ClientSecret.ValueString()
+	}
+
+	if !config.TokenURL.IsNull() {
+		tokenURL = config.TokenURL.ValueString()
+	}
+
+	if !config.ClientCertFile.IsNull() {
+		clientCertFile = config.ClientCertFile.ValueString()
+	}
+
+	if !config.ClientKeyFile.IsNull() {
+		clientKeyFile = config.ClientKeyFile.ValueString()
+	}
+
+	if !config.CACertFile.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+	}
+
+	if v := os.Getenv("HASHICUPS_INSECURE"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("insecure"),
+				"Invalid HASHICUPS_INSECURE Value",
+				"The HASHICUPS_INSECURE environment variable must be a boolean, got: "+v,
+			)
+			return
+		}
+		insecure = parsed
+	}
+
+	if !config.Insecure.IsNull() {
+		insecure = config.Insecure.ValueBool()
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxyURL = config.ProxyURL.ValueString()
+	}
+
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if !config.RetryMaxBackoff.IsNull() {
+		d, err := time.ParseDuration(config.RetryMaxBackoff.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_backoff"),
+				"Invalid HashiCups Retry Max Backoff",
+				"The retry_max_backoff value must be a duration such as \"30s\" or \"2m\": "+err.Error(),
+			)
+			return
+		}
+		retryMaxBackoff = d
+	}
+
+	if !config.RequestTimeout.IsNull() {
+		d, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid HashiCups Request Timeout",
+				"The request_timeout value must be a duration such as \"10s\" or \"1m\": "+err.Error(),
+			)
+			return
+		}
+		requestTimeout = d
+	}
+
+	if !config.CircuitBreakerThreshold.IsNull() {
+		circuitBreakerThreshold = int(config.CircuitBreakerThreshold.ValueInt64())
+	}
+
+	if !config.CircuitBreakerCooldown.IsNull() {
+		d, err := time.ParseDuration(config.CircuitBreakerCooldown.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("circuit_breaker_cooldown"),
+				"Invalid HashiCups Circuit Breaker Cooldown",
+				"The circuit_breaker_cooldown value must be a duration such as \"30s\" or \"1m\": "+err.Error(),
+			)
+			return
+		}
+		circuitBreakerCooldown = d
+	}
+
+	if !config.Scopes.IsNull() {
+		resp.Diagnostics.Append(config.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var tags map[string]string
+	if !defaultTags.Tags.IsNull() {
+		resp.Diagnostics.Append(defaultTags.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -144,7 +485,53 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
-	if username == "" {
+	// Credentials are used in order of precedence: a token as-is, OAuth2
+	// client credentials, and finally a username and password to sign in.
+
+	useOAuth2 := token == "" && (clientID != "" || clientSecret != "" || tokenURL != "")
+	useSignIn := token == "" && !useOAuth2
+
+	if useSignIn && username == "" && password == "" {
+		resp.Diagnostics.AddError(
+			"Missing HashiCups API Credentials",
+			"The provider cannot create the HashiCups API client as there are no HashiCups API credentials. "+
+				"Set the token value in the configuration or use the HASHICUPS_TOKEN environment variable, "+
+				"set client_id, client_secret and token_url for OAuth2 client credentials, "+
+				"or set both username and password in the configuration or with the HASHICUPS_USERNAME and HASHICUPS_PASSWORD environment variables.",
+		)
+	}
+
+	if useOAuth2 && clientID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Missing HashiCups OAuth2 Client ID",
+			"The provider cannot create the HashiCups API client as there is a missing or empty value for the OAuth2 client ID. "+
+				"Set the client_id value in the configuration or use the HASHICUPS_CLIENT_ID environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if useOAuth2 && clientSecret == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Missing HashiCups OAuth2 Client Secret",
+			"The provider cannot create the HashiCups API client as there is a missing or empty value for the OAuth2 client secret. "+
+				"Set the client_secret value in the configuration or use the HASHICUPS_CLIENT_SECRET environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if useOAuth2 && tokenURL == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_url"),
+			"Missing HashiCups OAuth2 Token URL",
+			"The provider cannot create the HashiCups API client as there is a missing or empty value for the OAuth2 token URL. "+
+				"Set the token_url value in the configuration or use the HASHICUPS_TOKEN_URL environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if useSignIn && username == "" && password != "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing HashiCups API Username",
@@ -154,7 +541,7 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
-	if password == "" {
+	if useSignIn && password == "" && username != "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing HashiCups API Password",
@@ -168,6 +555,113 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	// Client certificates may be given inline or as files, but not both.
+
+	clientCertPEM := readPEMSetting(config.ClientCertPEM, path.Root("client_cert_pem"), clientCertFile, path.Root("client_cert_file"), &resp.Diagnostics)
+	clientKeyPEM := readPEMSetting(config.ClientKeyPEM, path.Root("client_key_pem"), clientKeyFile, path.Root("client_key_file"), &resp.Diagnostics)
+	caCertPEM := readPEMSetting(config.CACertPEM, path.Root("ca_cert_pem"), caCertFile, path.Root("ca_cert_file"), &resp.Diagnostics)
+
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid HashiCups Max Retries",
+			"The max_retries value must not be negative. Set it to 0 to disable retries.",
+		)
+	}
+
+	if retryMaxBackoff <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_backoff"),
+			"Invalid HashiCups Retry Max Backoff",
+			"The retry_max_backoff value must be a positive duration.",
+		)
+	}
+
+	if requestTimeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid HashiCups Request Timeout",
+			"The request_timeout value must be a positive duration.",
+		)
+	}
+
+	if circuitBreakerThreshold < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("circuit_breaker_threshold"),
+			"Invalid HashiCups Circuit Breaker Threshold",
+			"The circuit_breaker_threshold value must not be negative. Set it to 0 to disable the circuit breaker.",
+		)
+	}
+
+	if circuitBreakerCooldown <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("circuit_breaker_cooldown"),
+			"Invalid HashiCups Circuit Breaker Cooldown",
+			"The circuit_breaker_cooldown value must be a positive duration.",
+		)
+	}
+
+	if (clientCertPEM == "") != (clientKeyPEM == "") {
+		resp.Diagnostics.AddError(
+			"Incomplete HashiCups Client Certificate",
+			"Mutual TLS requires both a client certificate and its private key. "+
+				"Set either client_cert_pem and client_key_pem, or client_cert_file and client_key_file.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if insecure {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure"),
+			"HashiCups TLS Verification Disabled",
+			"The provider will not verify the HashiCups API server's TLS certificate, which allows the connection to be intercepted. "+
+				"Only use insecure mode for testing; configure ca_cert_pem or ca_cert_file to trust a private certificate authority instead.",
+		)
+	}
+
+	httpTransport, err := newTransport(transportConfig{
+		ClientCertPEM: clientCertPEM,
+		ClientKeyPEM:  clientKeyPEM,
+		CACertPEM:     caCertPEM,
+		Insecure:      insecure,
+		ProxyURL:      proxyURL,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid HashiCups Transport Configuration",
+			"The provider cannot create the HTTP transport for the HashiCups API client.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	var baseTransport http.RoundTripper = httpTransport
+	if p.wrapTransport != nil {
+		baseTransport = p.wrapTransport(baseTransport)
+	}
+
+	// Transient failures are retried for every request the provider makes,
+	// including OAuth2 token requests. Each attempt is bounded by the
+	// request timeout on its own, so a hung request fails fast and is
+	// retried rather than blocking until Terraform gives up. Requests that
+	// still fail after their retries count towards the circuit breaker,
+	// which is shared by every resource and data source of this provider.
+	transport := &circuitBreakerTransport{
+		Base: &retryTransport{
+			Base: &timeoutTransport{
+				Base:    baseTransport,
+				Timeout: requestTimeout,
+			},
+			MaxRetries: maxRetries,
+			MaxBackoff: retryMaxBackoff,
+		},
+		Threshold: circuitBreakerThreshold,
+		Cooldown:  circuitBreakerCooldown,
+	}
+
 	ctx = tflog.SetField(ctx, "hashicups_host", host)
 	ctx = tflog.SetField(ctx, "hashicups_username", username)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "hashicups_password")
@@ -175,7 +669,7 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 	tflog.Debug(ctx, "Creating HashiCups client")
 
 	// Create a new HashiCups client using the configuration values
-	client, err := hashicups.NewClient(&host, &username, &password)
+	client, err := hashicups.NewClient(&host, nil, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create HashiCups API Client",
@@ -186,10 +680,71 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Make the HashiCups client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	// The transport enforces the request timeout per attempt, which the
+	// client's overall timeout would otherwise cut short across retries.
+	client.HTTPClient.Timeout = 0
+	client.HTTPClient.Transport = transport
+
+	switch {
+	case token != "":
+		client.Token = token
+	case useOAuth2:
+		// Every request carries a bearer token from the token endpoint,
+		// which is refreshed by the transport as it expires.
+		source := &auth.ClientCredentials{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			TokenURL:     tokenURL,
+			Scopes:       scopes,
+			HTTPClient:   &http.Client{Transport: transport},
+		}
+		client.HTTPClient.Transport = &auth.Transport{
+			Source: source,
+			Base:   client.HTTPClient.Transport,
+		}
+
+		tflog.Debug(ctx, "Requesting HashiCups OAuth2 access token")
+
+		if _, err := source.Token(ctx); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Acquire HashiCups OAuth2 Token",
+				"The provider could not obtain an access token from the configured OAuth2 token URL. "+
+					"Ensure the client ID, client secret, token URL and scopes are correct.\n\n"+
+					"OAuth2 Error: "+err.Error(),
+			)
+			return
+		}
+	default:
+		// Exchange the username and password for a token once, here, so
+		// that bad credentials fail provider configuration instead of
+		// every resource and data source operation.
+		client.Auth = hashicups.AuthStruct{
+			Username: username,
+			Password: password,
+		}
+
+		tflog.Debug(ctx, "Signing in to HashiCups")
+
+		signIn, err := client.SignIn()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Sign In to HashiCups",
+				"The provider could not exchange the configured username and password for a HashiCups API token. "+
+					"Ensure the credentials are correct and the user exists on the HashiCups API host.\n\n"+
+					"HashiCups Client Error: "+err.Error(),
+			)
+			return
+		}
+
+		client.Token = signIn.Token
+	}
+
+	// Make the HashiCups client available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
+	providerClient := &apiClient{Client: client, defaultTags: tags}
+	resp.DataSourceData = providerClient
+	resp.ResourceData = providerClient
+	resp.EphemeralResourceData = providerClient
 
 	tflog.Info(ctx, "Configured HashiCups client", map[string]any{"success": true})
 }
@@ -198,6 +753,19 @@ func (p *hashicupsProvider) Configure(ctx context.Context, req provider.Configur
 func (p *hashicupsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewCoffeesDataSource,
+		NewCafesDataSource,
+		NewCafeDataSource,
+		NewOrdersDataSource,
+		NewIngredientsDataSource,
+		NewUsersDataSource,
+		NewCurrentUserDataSource,
+		NewServerInfoDataSource,
+		NewCafeMenuDataSource,
+		NewReviewsDataSource,
+		NewInventoryDataSource,
+		NewNearestCafeDataSource,
+		NewCafeStatsDataSource,
+		NewCafeIDsDataSource,
 	}
 }
 
@@ -206,5 +774,81 @@ func (p *hashicupsProvider) Resources(_ context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewOrderResource,
 		NewCafeResource,
+		NewIngredientResource,
+		NewMenuResource,
+		NewUserResource,
+		NewAPIKeyResource,
+		NewReviewResource,
+		NewPromotionResource,
+		NewInventoryResource,
+		NewSupplierResource,
+		NewLoyaltyProgramResource,
+		NewGiftCardResource,
+		NewTaxRateResource,
+		NewDeliveryZoneResource,
+		NewReservationResource,
+		NewEventResource,
+		NewStaffResource,
+		NewRoleResource,
+		NewOrganizationResource,
+		NewFranchiseResource,
+		NewCafeHoursResource,
+		NewMenuItemResource,
+		NewCoffeeIngredientResource,
+		NewPriceListResource,
+		NewPaymentMethodResource,
+		NewNotificationChannelResource,
+		NewEquipmentResource,
+		NewStaffScheduleResource,
+		NewCafeManagerResource,
 	}
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the
+// provider.
+func (p *hashicupsProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTokenEphemeralResource,
+		NewStaffCredentialsEphemeralResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *hashicupsProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseCafeIDFunction,
+		NewSlugifyFunction,
+		NewPriceWithTaxFunction,
+		NewNormalizeAddressFunction,
+	}
+}
+
+// readPEMSetting returns PEM data configured either inline through the
+// pemValue attribute or through a file path, adding an error diagnostic when
+// both are set or the file cannot be read.
+func readPEMSetting(pemValue types.String, pemPath path.Path, file string, filePath path.Path, diags *diag.Diagnostics) string {
+	if !pemValue.IsNull() && file != "" {
+		diags.AddAttributeError(
+			pemPath,
+			"Conflicting HashiCups Provider Configuration",
+			fmt.Sprintf("Only one of %s and %s can be set.", pemPath, filePath),
+		)
+		return ""
+	}
+
+	if file == "" {
+		return pemValue.ValueString()
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		diags.AddAttributeError(
+			filePath,
+			"Unable to Read PEM File",
+			"The provider cannot read "+file+": "+err.Error(),
+		)
+		return ""
+	}
+
+	return string(data)
+}
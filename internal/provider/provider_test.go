@@ -4,10 +4,20 @@
 package provider
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"terraform-provider-inpyu-ossca/internal/testserver"
+	"terraform-provider-inpyu-ossca/internal/vcr"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -15,11 +25,141 @@ import (
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
+	"inpyu": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccProtoV6ProviderFactoriesWithCassette returns provider factories
+// whose HashiCups API traffic is replayed from the cassette of t, under
+// testdata/cassettes. With TF_ACC_RECORD set, the traffic is sent to the
+// configured API and recorded to the cassette instead. Tests without a
+// cassette use the API as testAccProtoV6ProviderFactories do.
+func testAccProtoV6ProviderFactoriesWithCassette(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "cassettes", t.Name()+".json")
+	mode := vcr.ModeFromEnv()
+
+	if mode == vcr.ModeReplay {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return testAccProtoV6ProviderFactories
+		}
+	}
+
+	recorder, err := vcr.New(path, mode)
+	if err != nil {
+		t.Fatalf("unexpected error loading cassette: %s", err)
+	}
+	t.Cleanup(func() {
+		// A skipped test made no requests, and must not overwrite the
+		// cassette with an empty one.
+		if t.Skipped() {
+			return
+		}
+
+		if err := recorder.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"inpyu": providerserver.NewProtocol6WithError(&hashicupsProvider{
+			version:       "test",
+			wrapTransport: recorder.Wrap,
+		}),
+	}
+}
+
+// TestMain runs acceptance tests against an in-memory HashiCups API unless
+// HASHICUPS_HOST points them at a real one, such as the docker-compose stack.
+// With -sweep it runs the sweepers against the given host instead.
+func TestMain(m *testing.M) {
+	resource.TestMain(testServerMain{m})
+}
+
+// testServerMain runs the tests with the provider configured for an
+// in-memory HashiCups API when acceptance tests are enabled and no other API
+// is configured.
+type testServerMain struct {
+	m *testing.M
+}
+
+func (t testServerMain) Run() int {
+	if os.Getenv("TF_ACC") == "" || os.Getenv("HASHICUPS_HOST") != "" {
+		return t.m.Run()
+	}
+
+	server := testserver.New()
+	defer server.Close()
+
+	os.Setenv("HASHICUPS_HOST", server.URL)
+	os.Setenv("HASHICUPS_USERNAME", testserver.Username)
+	os.Setenv("HASHICUPS_PASSWORD", testserver.Password)
+
+	return t.m.Run()
 }
 
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
-}
\ No newline at end of file
+}
+
+func TestProviderConfigureUnknownConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		deferralAllowed bool
+		expectDeferred  bool
+		expectError     bool
+	}{
+		"deferral-allowed": {
+			deferralAllowed: true,
+			expectDeferred:  true,
+		},
+		"deferral-not-allowed": {
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			p := New("test")()
+
+			var schemaResp provider.SchemaResponse
+			p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+			configType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				t.Fatalf("expected the provider schema to be an object, got: %T", schemaResp.Schema.Type().TerraformType(ctx))
+			}
+
+			values := map[string]tftypes.Value{}
+			for attrName, attrType := range configType.AttributeTypes {
+				values[attrName] = tftypes.NewValue(attrType, nil)
+			}
+			values["host"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(configType, values),
+				},
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: testCase.deferralAllowed,
+				},
+			}
+			var resp provider.ConfigureResponse
+			p.Configure(ctx, req, &resp)
+
+			if got := resp.Deferred != nil; got != testCase.expectDeferred {
+				t.Errorf("expected deferred %t, got %t", testCase.expectDeferred, got)
+			}
+			if got := resp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
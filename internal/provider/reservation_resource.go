@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &reservationResource{}
+	_ resource.ResourceWithConfigure      = &reservationResource{}
+	_ resource.ResourceWithValidateConfig = &reservationResource{}
+	_ resource.ResourceWithImportState    = &reservationResource{}
+)
+
+// NewReservationResource is a helper function to simplify the provider implementation.
+func NewReservationResource() resource.Resource {
+	return &reservationResource{}
+}
+
+// reservationResource is the resource implementation. Reservations are
+// mostly managed by Terraform to seed test and demo environments.
+type reservationResource struct {
+	client *apiClient
+}
+
+// reservationResourceModel maps the resource schema data.
+type reservationResourceModel struct {
+	ID        types.String              `tfsdk:"id"`
+	CafeID    types.String              `tfsdk:"cafe_id"`
+	PartySize types.Int64               `tfsdk:"party_size"`
+	StartsAt  types.String              `tfsdk:"starts_at"`
+	EndsAt    types.String              `tfsdk:"ends_at"`
+	Customer  *reservationCustomerModel `tfsdk:"customer"`
+}
+
+// reservationCustomerModel maps the customer a table is reserved for.
+type reservationCustomerModel struct {
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Phone types.String `tfsdk:"phone"`
+}
+
+// apiReservation is the API representation of a table reservation at a
+// cafe.
+type apiReservation struct {
+	ID        int                    `json:"id,omitempty"`
+	CafeID    int                    `json:"cafe_id"`
+	PartySize int64                  `json:"party_size"`
+	StartsAt  string                 `json:"starts_at"`
+	EndsAt    string                 `json:"ends_at"`
+	Customer  apiReservationCustomer `json:"customer"`
+}
+
+// apiReservationCustomer is the API representation of the customer of a
+// reservation. Unset fields are empty.
+type apiReservationCustomer struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *reservationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservation"
+}
+
+// Schema defines the schema for the resource.
+func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"party_size": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RFC3339(),
+				},
+			},
+			"customer": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"email": schema.StringAttribute{
+						Optional: true,
+					},
+					"phone": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the reservation ends after it starts.
+func (r *reservationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var startsAt, endsAt types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("starts_at"), &startsAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ends_at"), &endsAt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTimeWindow(startsAt, endsAt, path.Root("ends_at"), "Invalid Reservation Time", &resp.Diagnostics)
+}
+
+// Create a new resource.
+func (r *reservationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan reservationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reservation, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiReservation
+	err := r.client.do(ctx, http.MethodPost, "/reservations", reservation, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating reservation",
+			"Could not create reservation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state reservationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var reservation apiReservation
+	err := r.client.do(ctx, http.MethodGet, "/reservations/"+state.ID.ValueString(), nil, &reservation)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups reservation not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Reservation",
+			"Could not read HashiCups reservation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(reservation)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *reservationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan reservationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reservation, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiReservation
+	err := r.client.do(ctx, http.MethodPut, "/reservations/"+plan.ID.ValueString(), reservation, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Reservation",
+			"Could not update reservation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *reservationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state reservationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/reservations/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Reservation Already Deleted",
+			"The reservation was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Reservation",
+			"Could not delete reservation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *reservationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing reservation by its ID.
+func (r *reservationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m reservationResourceModel) toAPI() (apiReservation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	reservation := apiReservation{
+		CafeID:    parseObjectID(path.Root("cafe_id"), m.CafeID.ValueString(), &diags),
+		PartySize: m.PartySize.ValueInt64(),
+		StartsAt:  m.StartsAt.ValueString(),
+		EndsAt:    m.EndsAt.ValueString(),
+	}
+	if m.Customer != nil {
+		reservation.Customer = apiReservationCustomer{
+			Name:  m.Customer.Name.ValueString(),
+			Email: m.Customer.Email.ValueString(),
+			Phone: m.Customer.Phone.ValueString(),
+		}
+	}
+
+	return reservation, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *reservationResourceModel) fromAPI(reservation apiReservation) {
+	m.ID = types.StringValue(strconv.Itoa(reservation.ID))
+	m.CafeID = types.StringValue(strconv.Itoa(reservation.CafeID))
+	m.PartySize = types.Int64Value(reservation.PartySize)
+	m.StartsAt = types.StringValue(reservation.StartsAt)
+	m.EndsAt = types.StringValue(reservation.EndsAt)
+	m.Customer = &reservationCustomerModel{
+		Name:  types.StringValue(reservation.Customer.Name),
+		Email: stringValueOrNull(reservation.Customer.Email),
+		Phone: stringValueOrNull(reservation.Customer.Phone),
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultMaxRetries is how many times a failed request is retried when
+	// the provider configuration does not set max_retries.
+	defaultMaxRetries = 3
+
+	// defaultRetryMaxBackoff caps the wait between retries when the provider
+	// configuration does not set retry_max_backoff.
+	defaultRetryMaxBackoff = 30 * time.Second
+
+	// retryMinBackoff is the wait before the first retry. It doubles with
+	// every further attempt, up to the maximum backoff.
+	retryMinBackoff = 500 * time.Millisecond
+)
+
+// retryTransport is an http.RoundTripper that retries requests failing with
+// a network error or a 5xx response, waiting an exponentially growing,
+// jittered delay between attempts. Only requests that are safe to send twice
+// are retried, as the API may have acted on an attempt that failed.
+type retryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	MaxBackoff time.Duration
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attemptReq := req
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.Base.RoundTrip(attemptReq)
+
+		if attempt >= t.MaxRetries || !retryableRequest(req) || !retryable(res, err) {
+			return res, err
+		}
+
+		if res != nil {
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		wait := t.backoff(attempt)
+		fields := map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = res.StatusCode
+		}
+		tflog.Debug(ctx, "Retrying HashiCups API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		attemptReq = req.Clone(ctx)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+	}
+}
+
+// backoff returns the delay before retry number attempt+1: an exponentially
+// growing base delay capped at MaxBackoff, with jitter applied to the upper
+// half so concurrent clients do not retry in lockstep.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	wait := t.MaxBackoff
+	if attempt < 30 {
+		if d := retryMinBackoff << attempt; d < wait {
+			wait = d
+		}
+	}
+
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+
+	return half + rand.N(half)
+}
+
+// retryableRequest reports whether req can be sent again: its body can be
+// replayed, and its method is idempotent or it carries an Idempotency-Key,
+// so that a retry cannot create a second object.
+func retryableRequest(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+
+	return req.Header.Get(idempotencyKeyHeader) != ""
+}
+
+// retryable reports whether a request that produced res and err is worth
+// retrying.
+func retryable(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return res.StatusCode >= 500
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		failures       int
+		maxRetries     int
+		expectedStatus int
+		expectedCalls  int
+	}{
+		"success": {
+			failures:       0,
+			maxRetries:     3,
+			expectedStatus: http.StatusOK,
+			expectedCalls:  1,
+		},
+		"recovers": {
+			failures:       2,
+			maxRetries:     3,
+			expectedStatus: http.StatusOK,
+			expectedCalls:  3,
+		},
+		"exhausted": {
+			failures:       5,
+			maxRetries:     2,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedCalls:  3,
+		},
+		"disabled": {
+			failures:       1,
+			maxRetries:     0,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedCalls:  1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"name":"cafe"}` {
+					t.Errorf("attempt %d: unexpected request body %q", calls, body)
+				}
+
+				if calls <= testCase.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+			}))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: &retryTransport{
+					Base:       http.DefaultTransport,
+					MaxRetries: testCase.maxRetries,
+					MaxBackoff: time.Millisecond,
+				},
+			}
+
+			req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"cafe"}`))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			res, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != testCase.expectedStatus {
+				t.Errorf("expected status %d, got %d", testCase.expectedStatus, res.StatusCode)
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRetryTransportReplaysHeaders(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		if got := r.Header.Get(idempotencyKeyHeader); got != "key" {
+			t.Errorf("attempt %d: expected idempotency key %q, got %q", calls, "key", got)
+		}
+
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &retryTransport{
+			Base:       http.DefaultTransport,
+			MaxRetries: 1,
+			MaxBackoff: time.Millisecond,
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"name":"cafe"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	req.Header.Set(idempotencyKeyHeader, "key")
+
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestRetryTransportNonIdempotent(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &retryTransport{
+			Base:       http.DefaultTransport,
+			MaxRetries: 3,
+			MaxBackoff: time.Millisecond,
+		},
+	}
+
+	res, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"latte"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, res.StatusCode)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	t.Parallel()
+
+	transport := &retryTransport{MaxBackoff: 4 * time.Second}
+
+	for attempt, expectedMax := range []time.Duration{
+		500 * time.Millisecond,
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		4 * time.Second,
+		4 * time.Second,
+	} {
+		got := transport.backoff(attempt)
+		if got < expectedMax/2 || got > expectedMax {
+			t.Errorf("attempt %d: expected backoff between %s and %s, got %s", attempt, expectedMax/2, expectedMax, got)
+		}
+	}
+}
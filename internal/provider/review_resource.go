@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &reviewResource{}
+	_ resource.ResourceWithConfigure   = &reviewResource{}
+	_ resource.ResourceWithImportState = &reviewResource{}
+)
+
+// NewReviewResource is a helper function to simplify the provider implementation.
+func NewReviewResource() resource.Resource {
+	return &reviewResource{}
+}
+
+// reviewResource is the resource implementation.
+type reviewResource struct {
+	client *apiClient
+}
+
+// reviewResourceModel maps the resource schema data.
+type reviewResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	CafeID types.String `tfsdk:"cafe_id"`
+	Rating types.Int64  `tfsdk:"rating"`
+	Body   types.String `tfsdk:"body"`
+	Author types.String `tfsdk:"author"`
+}
+
+// apiReview is the API representation of a review of a cafe.
+type apiReview struct {
+	ID     int    `json:"id,omitempty"`
+	CafeID int    `json:"cafe_id"`
+	Rating int    `json:"rating"`
+	Body   string `json:"body"`
+	Author string `json:"author"`
+}
+
+// Metadata returns the resource type name.
+func (r *reviewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_review"
+}
+
+// Schema defines the schema for the resource.
+func (r *reviewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rating": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+			"body": schema.StringAttribute{
+				Optional: true,
+			},
+			"author": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *reviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan reviewResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	review, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiReview
+	err := r.client.do(ctx, http.MethodPost, "/reviews", review, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating review",
+			"Could not create review, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *reviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state reviewResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var review apiReview
+	err := r.client.do(ctx, http.MethodGet, "/reviews/"+state.ID.ValueString(), nil, &review)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups review not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Review",
+			"Could not read HashiCups review ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(review)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *reviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan reviewResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	review, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiReview
+	err := r.client.do(ctx, http.MethodPut, "/reviews/"+plan.ID.ValueString(), review, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Review",
+			"Could not update review, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *reviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state reviewResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/reviews/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Review Already Deleted",
+			"The review was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Review",
+			"Could not delete review, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *reviewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing review by its ID.
+func (r *reviewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m reviewResourceModel) toAPI() (apiReview, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	review := apiReview{
+		CafeID: parseObjectID(path.Root("cafe_id"), m.CafeID.ValueString(), &diags),
+		Rating: int(m.Rating.ValueInt64()),
+		Body:   m.Body.ValueString(),
+		Author: m.Author.ValueString(),
+	}
+
+	return review, diags
+}
+
+// fromAPI maps an API response body onto the resource model. An empty body
+// is kept null.
+func (m *reviewResourceModel) fromAPI(review apiReview) {
+	m.ID = types.StringValue(strconv.Itoa(review.ID))
+	m.CafeID = types.StringValue(strconv.Itoa(review.CafeID))
+	m.Rating = types.Int64Value(int64(review.Rating))
+	m.Body = types.StringNull()
+	if review.Body != "" {
+		m.Body = types.StringValue(review.Body)
+	}
+	m.Author = types.StringValue(review.Author)
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &reviewsDataSource{}
+	_ datasource.DataSourceWithConfigure = &reviewsDataSource{}
+)
+
+// NewReviewsDataSource is a helper function to simplify the provider implementation.
+func NewReviewsDataSource() datasource.DataSource {
+	return &reviewsDataSource{}
+}
+
+// reviewsDataSource is the data source implementation.
+type reviewsDataSource struct {
+	client *apiClient
+}
+
+// reviewsDataSourceModel maps the data source schema data.
+type reviewsDataSourceModel struct {
+	CafeID    types.String   `tfsdk:"cafe_id"`
+	MinRating types.Int64    `tfsdk:"min_rating"`
+	Limit     types.Int64    `tfsdk:"limit"`
+	PageSize  types.Int64    `tfsdk:"page_size"`
+	SortBy    types.String   `tfsdk:"sort_by"`
+	SortOrder types.String   `tfsdk:"sort_order"`
+	Reviews   []reviewsModel `tfsdk:"reviews"`
+}
+
+// reviewsModel maps reviews schema data.
+type reviewsModel struct {
+	ID     types.String `tfsdk:"id"`
+	Rating types.Int64  `tfsdk:"rating"`
+	Body   types.String `tfsdk:"body"`
+	Author types.String `tfsdk:"author"`
+}
+
+// reviewsSortFields are the fields the reviews can be sorted by.
+var reviewsSortFields = sortFields[apiReview]{
+	"id": func(a, b apiReview) int {
+		return cmp.Compare(a.ID, b.ID)
+	},
+	"rating": func(a, b apiReview) int {
+		return cmp.Compare(a.Rating, b.Rating)
+	},
+	"author": func(a, b apiReview) int {
+		return cmp.Compare(a.Author, b.Author)
+	},
+}
+
+// Metadata returns the data source type name.
+func (d *reviewsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reviews"
+}
+
+// Schema defines the schema for the data source.
+func (d *reviewsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+			},
+			"min_rating": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+			// limit caps the number of reviews, which are kept in the
+			// order the API lists them in.
+			"limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"page_size":  pageSizeAttribute(),
+			"sort_by":    sortByAttribute(reviewsSortFields),
+			"sort_order": sortOrderAttribute(),
+			"reviews": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"rating": schema.Int64Attribute{
+							Computed: true,
+						},
+						"body": schema.StringAttribute{
+							Computed: true,
+						},
+						"author": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the reviews of the cafe. The
+// reviews are listed by the API, and min_rating and limit are applied to
+// the list.
+func (d *reviewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state reviewsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{"cafe_id": {state.CafeID.ValueString()}}
+
+	reviews, err := listSorted(ctx, d.client, "/reviews", query, newListOptions(state.Limit, state.PageSize), reviewsSortFields, state.SortBy, state.SortOrder, func(review apiReview) bool {
+		return state.MinRating.IsNull() || int64(review.Rating) >= state.MinRating.ValueInt64()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Reviews",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Reviews = []reviewsModel{}
+	for _, review := range reviews {
+		state.Reviews = append(state.Reviews, reviewsModel{
+			ID:     types.StringValue(strconv.Itoa(review.ID)),
+			Rating: types.Int64Value(int64(review.Rating)),
+			Body:   stringValueOrNull(review.Body),
+			Author: types.StringValue(review.Author),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *reviewsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
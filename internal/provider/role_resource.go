@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &roleResource{}
+	_ resource.ResourceWithConfigure   = &roleResource{}
+	_ resource.ResourceWithModifyPlan  = &roleResource{}
+	_ resource.ResourceWithImportState = &roleResource{}
+)
+
+// NewRoleResource is a helper function to simplify the provider implementation.
+func NewRoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+// roleResource is the resource implementation.
+type roleResource struct {
+	client *apiClient
+}
+
+// roleResourceModel maps the resource schema data.
+type roleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+// apiRole is the API representation of a custom role, which grants its
+// members a set of permissions.
+type apiRole struct {
+	ID          int      `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// Metadata returns the resource type name.
+func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			// permissions are checked against the permissions the API
+			// knows about when planning, such as "cafes:read".
+			"permissions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan fails the plan when a permission is not one the API knows
+// about, instead of the apply failing. It is skipped while the provider is
+// not configured yet or the permissions are unknown.
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var permissions types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	if resp.Diagnostics.HasError() || permissions.IsUnknown() {
+		return
+	}
+	for _, permission := range permissions.Elements() {
+		if permission.IsUnknown() {
+			return
+		}
+	}
+
+	var planned []string
+	resp.Diagnostics.Append(permissions.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var known []string
+	err := r.client.do(ctx, http.MethodGet, "/permissions", nil, &known)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Permissions",
+			"Could not check the role's permissions against the permissions known to the API: "+err.Error(),
+		)
+		return
+	}
+
+	for _, permission := range planned {
+		if !slices.Contains(known, permission) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("permissions"),
+				"Unknown Permission",
+				fmt.Sprintf("The permission %q is not known to the HashiCups API. Known permissions are: %q", permission, known),
+			)
+		}
+	}
+}
+
+// Create a new resource.
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiRole
+	err := r.client.do(ctx, http.MethodPost, "/roles", role, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating role",
+			"Could not create role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role apiRole
+	err := r.client.do(ctx, http.MethodGet, "/roles/"+state.ID.ValueString(), nil, &role)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups role not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Role",
+			"Could not read HashiCups role ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(ctx, role)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiRole
+	err := r.client.do(ctx, http.MethodPut, "/roles/"+plan.ID.ValueString(), role, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Role",
+			"Could not update role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/roles/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Role Already Deleted",
+			"The role was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Role",
+			"Could not delete role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *roleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing role by its ID.
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m roleResourceModel) toAPI(ctx context.Context) (apiRole, diag.Diagnostics) {
+	role := apiRole{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Permissions: []string{},
+	}
+	diags := m.Permissions.ElementsAs(ctx, &role.Permissions, false)
+
+	return role, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *roleResourceModel) fromAPI(ctx context.Context, role apiRole) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.Itoa(role.ID))
+	m.Name = types.StringValue(role.Name)
+	m.Description = stringValueOrNull(role.Description)
+	// permissions is required, so a role without permissions has an empty
+	// set rather than a null one.
+	permissions := role.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	m.Permissions, diags = types.SetValueFrom(ctx, types.StringType, permissions)
+
+	return diags
+}
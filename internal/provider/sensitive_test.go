@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// credentialSuffixes are attribute name suffixes that mark an attribute as
+// carrying a credential. Such attributes must be Sensitive so that their
+// values are redacted from plan output.
+var credentialSuffixes = []string{"password", "secret", "token", "api_key", "key_pem", "proxy_url"}
+
+func TestSchemasMarkCredentialsSensitive(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var providerResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &providerResp)
+	assertCredentialsSensitive(t, "provider", providerResp.Schema.Attributes)
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		var metadataResp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "inpyu"}, &metadataResp)
+
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		assertCredentialsSensitive(t, metadataResp.TypeName, schemaResp.Schema.Attributes)
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+
+		var metadataResp datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "inpyu"}, &metadataResp)
+
+		var schemaResp datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+		assertCredentialsSensitive(t, "data."+metadataResp.TypeName, schemaResp.Schema.Attributes)
+	}
+}
+
+func assertCredentialsSensitive[A interface{ IsSensitive() bool }](t *testing.T, owner string, attributes map[string]A) {
+	t.Helper()
+
+	for name, attribute := range attributes {
+		for _, suffix := range credentialSuffixes {
+			if strings.HasSuffix(name, suffix) && !attribute.IsSensitive() {
+				t.Errorf("%s: attribute %q carries a credential and must be Sensitive", owner, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverInfoDataSource{}
+)
+
+// NewServerInfoDataSource is a helper function to simplify the provider implementation.
+func NewServerInfoDataSource() datasource.DataSource {
+	return &serverInfoDataSource{}
+}
+
+// serverInfoDataSource is the data source implementation.
+type serverInfoDataSource struct {
+	client *apiClient
+}
+
+// serverInfoDataSourceModel maps the data source schema data.
+type serverInfoDataSourceModel struct {
+	Version     types.String `tfsdk:"version"`
+	APIVersions types.List   `tfsdk:"api_versions"`
+	Features    types.Set    `tfsdk:"features"`
+}
+
+// apiServerInfo is the API representation of the version and capabilities
+// of the HashiCups API server.
+type apiServerInfo struct {
+	Version     string   `json:"version"`
+	APIVersions []string `json:"api_versions"`
+	Features    []string `json:"features"`
+}
+
+// Metadata returns the data source type name.
+func (d *serverInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Computed: true,
+			},
+			// api_versions are the versions of the API the server
+			// supports, such as "v1".
+			"api_versions": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			// features are the optional features enabled on the server,
+			// such as "webhooks", so that configurations can check for one
+			// with contains() before using it.
+			"features": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serverInfoDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var info apiServerInfo
+	err := d.client.do(ctx, http.MethodGet, "/info", nil, &info)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Server Info",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	var state serverInfoDataSourceModel
+	resp.Diagnostics.Append(state.fromAPI(ctx, info)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serverInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// fromAPI maps an API response body onto the data source model. Missing
+// lists are empty rather than null, so they can be used with functions
+// such as contains().
+func (m *serverInfoDataSourceModel) fromAPI(ctx context.Context, info apiServerInfo) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	apiVersions := info.APIVersions
+	if apiVersions == nil {
+		apiVersions = []string{}
+	}
+	features := info.Features
+	if features == nil {
+		features = []string{}
+	}
+
+	m.Version = types.StringValue(info.Version)
+	m.APIVersions, d = types.ListValueFrom(ctx, types.StringType, apiVersions)
+	diags.Append(d...)
+	m.Features, d = types.SetValueFrom(ctx, types.StringType, features)
+	diags.Append(d...)
+
+	return diags
+}
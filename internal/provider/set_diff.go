@@ -0,0 +1,21 @@
+package provider
+
+import "slices"
+
+// diffStrings returns the elements of newElems missing from oldElems, and
+// the elements of oldElems missing from newElems.
+func diffStrings(oldElems, newElems []string) ([]string, []string) {
+	var added, removed []string
+	for _, elem := range newElems {
+		if !slices.Contains(oldElems, elem) {
+			added = append(added, elem)
+		}
+	}
+	for _, elem := range oldElems {
+		if !slices.Contains(newElems, elem) {
+			removed = append(removed, elem)
+		}
+	}
+
+	return added, removed
+}
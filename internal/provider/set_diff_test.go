@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiffStrings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldElems        []string
+		newElems        []string
+		expectedAdded   []string
+		expectedRemoved []string
+	}{
+		"unchanged": {
+			oldElems: []string{"1", "2"},
+			newElems: []string{"2", "1"},
+		},
+		"added": {
+			oldElems:      []string{"1"},
+			newElems:      []string{"1", "2", "3"},
+			expectedAdded: []string{"2", "3"},
+		},
+		"removed": {
+			oldElems:        []string{"1", "2"},
+			newElems:        []string{"2"},
+			expectedRemoved: []string{"1"},
+		},
+		"replaced": {
+			oldElems:        []string{"1", "2"},
+			newElems:        []string{"2", "3"},
+			expectedAdded:   []string{"3"},
+			expectedRemoved: []string{"1"},
+		},
+		"from-empty": {
+			newElems:      []string{"1"},
+			expectedAdded: []string{"1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			added, removed := diffStrings(testCase.oldElems, testCase.newElems)
+
+			if !slices.Equal(added, testCase.expectedAdded) {
+				t.Errorf("expected added %q, got %q", testCase.expectedAdded, added)
+			}
+			if !slices.Equal(removed, testCase.expectedRemoved) {
+				t.Errorf("expected removed %q, got %q", testCase.expectedRemoved, removed)
+			}
+		})
+	}
+}
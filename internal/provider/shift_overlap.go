@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"slices"
+	"time"
+)
+
+// minutesPerWeek is the length of the weekly cycle shifts repeat on.
+const minutesPerWeek = 7 * 24 * 60
+
+// shiftsOverlap reports whether two weekly shifts overlap in time, whoever
+// they are assigned to. Shifts that end at or before they start end after
+// midnight, on the following day, and a shift on sunday may run into
+// monday. Shifts with an unknown day or a malformed time never overlap.
+func shiftsOverlap(a, b apiStaffShift) bool {
+	aStart, aLength, aOK := shiftMinutes(a)
+	bStart, bLength, bOK := shiftMinutes(b)
+	if !aOK || !bOK {
+		return false
+	}
+
+	// Shifts are intervals on the weekly cycle, so each overlaps the other
+	// if it starts while the other is running.
+	return (bStart-aStart+minutesPerWeek)%minutesPerWeek < aLength ||
+		(aStart-bStart+minutesPerWeek)%minutesPerWeek < bLength
+}
+
+// shiftMinutes returns the minute of the week a shift starts at, counting
+// from midnight on monday, and how many minutes it lasts.
+func shiftMinutes(shift apiStaffShift) (int, int, bool) {
+	day := slices.Index(weekdays, shift.Day)
+	starts, startsErr := time.Parse("15:04", shift.Starts)
+	ends, endsErr := time.Parse("15:04", shift.Ends)
+	if day < 0 || startsErr != nil || endsErr != nil {
+		return 0, 0, false
+	}
+
+	length := int(ends.Sub(starts).Minutes())
+	if length <= 0 {
+		length += 24 * 60
+	}
+
+	return day*24*60 + starts.Hour()*60 + starts.Minute(), length, true
+}
@@ -0,0 +1,63 @@
+package provider
+
+import "testing"
+
+func TestShiftsOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a        apiStaffShift
+		b        apiStaffShift
+		expected bool
+	}{
+		"overlapping": {
+			a:        apiStaffShift{Day: "monday", Starts: "07:00", Ends: "12:00"},
+			b:        apiStaffShift{Day: "monday", Starts: "11:00", Ends: "15:00"},
+			expected: true,
+		},
+		"contained": {
+			a:        apiStaffShift{Day: "monday", Starts: "07:00", Ends: "17:00"},
+			b:        apiStaffShift{Day: "monday", Starts: "09:00", Ends: "10:00"},
+			expected: true,
+		},
+		"back-to-back": {
+			a: apiStaffShift{Day: "monday", Starts: "07:00", Ends: "12:00"},
+			b: apiStaffShift{Day: "monday", Starts: "12:00", Ends: "17:00"},
+		},
+		"different-days": {
+			a: apiStaffShift{Day: "monday", Starts: "07:00", Ends: "12:00"},
+			b: apiStaffShift{Day: "tuesday", Starts: "07:00", Ends: "12:00"},
+		},
+		"past-midnight": {
+			a:        apiStaffShift{Day: "friday", Starts: "22:00", Ends: "02:00"},
+			b:        apiStaffShift{Day: "saturday", Starts: "01:00", Ends: "05:00"},
+			expected: true,
+		},
+		"past-midnight-end-of-week": {
+			a:        apiStaffShift{Day: "sunday", Starts: "22:00", Ends: "06:00"},
+			b:        apiStaffShift{Day: "monday", Starts: "05:00", Ends: "09:00"},
+			expected: true,
+		},
+		"past-midnight-back-to-back": {
+			a: apiStaffShift{Day: "sunday", Starts: "22:00", Ends: "06:00"},
+			b: apiStaffShift{Day: "monday", Starts: "06:00", Ends: "09:00"},
+		},
+		"malformed": {
+			a: apiStaffShift{Day: "monday", Starts: "7am", Ends: "12:00"},
+			b: apiStaffShift{Day: "monday", Starts: "07:00", Ends: "12:00"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := shiftsOverlap(testCase.a, testCase.b); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+			if got := shiftsOverlap(testCase.b, testCase.a); got != testCase.expected {
+				t.Errorf("expected %t with the shifts swapped, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-inpyu-ossca/internal/types/normalizedstring"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// slugify returns the URL and DNS friendly form of s: its ASCII letters and
+// digits in lowercase, with every run of other characters replaced by a
+// single hyphen and no leading or trailing hyphens.
+func slugify(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(s) {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+		} else {
+			pendingHyphen = true
+		}
+	}
+
+	return b.String()
+}
+
+// slugFromName returns a plan modifier that plans a slug attribute as the
+// slugified name attribute, so that the slug is known before apply whenever
+// the name is. The slug is null while the name is.
+func slugFromName() planmodifier.String {
+	return slugFromNameModifier{}
+}
+
+type slugFromNameModifier struct{}
+
+func (m slugFromNameModifier) Description(_ context.Context) string {
+	return "The slug is derived from the name."
+}
+
+func (m slugFromNameModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m slugFromNameModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var name normalizedstring.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case name.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+	case name.IsNull():
+		resp.PlanValue = types.StringNull()
+	default:
+		resp.PlanValue = types.StringValue(slugify(name.ValueString()))
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     string
+		expected string
+	}{
+		"simple": {
+			name:     "Sample Cafe",
+			expected: "sample-cafe",
+		},
+		"surrounding-whitespace": {
+			name:     "  Sample Cafe  ",
+			expected: "sample-cafe",
+		},
+		"punctuation": {
+			name:     "Joe's Coffee & Tea, No. 2",
+			expected: "joe-s-coffee-tea-no-2",
+		},
+		"non-ascii": {
+			name:     "Café Straße",
+			expected: "caf-stra-e",
+		},
+		"only-separators": {
+			name:     " - ",
+			expected: "",
+		},
+		"empty": {
+			name:     "",
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := slugify(testCase.name)
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &slugifyFunction{}
+)
+
+// NewSlugifyFunction is a helper function to simplify the provider implementation.
+func NewSlugifyFunction() function.Function {
+	return &slugifyFunction{}
+}
+
+// slugifyFunction is the function implementation.
+type slugifyFunction struct{}
+
+// Metadata returns the function name.
+func (f *slugifyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *slugifyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Slugify a name",
+		Description: "Returns the slug of a name as computed for the slug attribute of the cafe resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to slugify.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run slugifies the name.
+func (f *slugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, slugify(name)))
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"net/url"
+	"slices"
+	"time"
+
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	sortOrderAscending  = "asc"
+	sortOrderDescending = "desc"
+)
+
+// sortFields are the fields a list data source can be sorted by, keyed by
+// the sort_by value that selects them. Each function compares two items by
+// the field, returning a negative number, zero or a positive number as in
+// cmp.Compare.
+type sortFields[T any] map[string]func(a, b T) int
+
+// sortItems sorts items in place by the field sortBy names, in the
+// direction sortOrder names. Items that compare equal keep the order the API
+// listed them in, and items are left in that order when sortBy is null.
+func sortItems[T any](items []T, fields sortFields[T], sortBy, sortOrder types.String) {
+	compare, ok := fields[sortBy.ValueString()]
+	if sortBy.IsNull() || !ok {
+		return
+	}
+
+	if sortOrder.ValueString() == sortOrderDescending {
+		slices.SortStableFunc(items, func(a, b T) int {
+			return compare(b, a)
+		})
+		return
+	}
+
+	slices.SortStableFunc(items, compare)
+}
+
+// listSorted lists the items of a paginated list endpoint like listAll and
+// sorts them with sortItems. When sortBy is set, every item is listed and
+// opts.MaxItems is applied once they are sorted, so that the items kept are
+// the first ones in sorted order rather than the first ones the API lists.
+func listSorted[T any](ctx context.Context, c *apiClient, path string, query url.Values, opts listOptions, fields sortFields[T], sortBy, sortOrder types.String, keep func(T) bool) ([]T, error) {
+	if _, ok := fields[sortBy.ValueString()]; sortBy.IsNull() || !ok {
+		return listAll(ctx, c, path, query, opts, keep)
+	}
+
+	maxItems := opts.MaxItems
+	opts.MaxItems = 0
+
+	items, err := listAll(ctx, c, path, query, opts, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	sortItems(items, fields, sortBy, sortOrder)
+
+	if maxItems > 0 && len(items) > maxItems {
+		items = items[:maxItems]
+	}
+
+	return items, nil
+}
+
+// compareTimestamps compares two RFC3339 timestamps by the instant they
+// name, so that timestamps in different time zones sort correctly. Values
+// that are not RFC3339 timestamps are compared as strings.
+func compareTimestamps(a, b string) int {
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return cmp.Compare(a, b)
+	}
+
+	return timeA.Compare(timeB)
+}
+
+// sortByAttribute returns the sort_by argument of a list data source that
+// can be sorted by the given fields.
+func sortByAttribute[T any](fields sortFields[T]) schema.StringAttribute {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "The field to sort the results by. Results are returned in the order the API lists them in when not set. When the number of results is limited, every result is sorted before the first ones are kept.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(names...),
+		},
+	}
+}
+
+// sortOrderAttribute returns the sort_order argument of list data sources.
+func sortOrderAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "The direction to sort the results by sort_by in, either \"" + sortOrderAscending + "\" or \"" + sortOrderDescending + "\". Defaults to \"" + sortOrderAscending + "\".",
+		Validators: []validator.String{
+			stringvalidator.OneOf(sortOrderAscending, sortOrderDescending),
+		},
+	}
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+func TestSortItems(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		ID   int
+		Name string
+	}
+
+	fields := sortFields[item]{
+		"id": func(a, b item) int {
+			return cmp.Compare(a.ID, b.ID)
+		},
+		"name": func(a, b item) int {
+			return cmp.Compare(a.Name, b.Name)
+		},
+	}
+
+	testCases := map[string]struct {
+		sortBy    types.String
+		sortOrder types.String
+		expected  []int
+	}{
+		"unsorted": {
+			sortBy:    types.StringNull(),
+			sortOrder: types.StringNull(),
+			expected:  []int{3, 1, 2, 4},
+		},
+		"unsorted-descending": {
+			sortBy:    types.StringNull(),
+			sortOrder: types.StringValue("desc"),
+			expected:  []int{3, 1, 2, 4},
+		},
+		"id": {
+			sortBy:    types.StringValue("id"),
+			sortOrder: types.StringNull(),
+			expected:  []int{1, 2, 3, 4},
+		},
+		"id-ascending": {
+			sortBy:    types.StringValue("id"),
+			sortOrder: types.StringValue("asc"),
+			expected:  []int{1, 2, 3, 4},
+		},
+		"id-descending": {
+			sortBy:    types.StringValue("id"),
+			sortOrder: types.StringValue("desc"),
+			expected:  []int{4, 3, 2, 1},
+		},
+		"name-stable": {
+			sortBy:    types.StringValue("name"),
+			sortOrder: types.StringNull(),
+			expected:  []int{1, 2, 3, 4},
+		},
+		"name-descending-stable": {
+			sortBy:    types.StringValue("name"),
+			sortOrder: types.StringValue("desc"),
+			expected:  []int{3, 4, 1, 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			items := []item{
+				{ID: 3, Name: "mocha"},
+				{ID: 1, Name: "latte"},
+				{ID: 2, Name: "latte"},
+				{ID: 4, Name: "mocha"},
+			}
+
+			sortItems(items, fields, testCase.sortBy, testCase.sortOrder)
+
+			var ids []int
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+
+			if !slices.Equal(ids, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, ids)
+			}
+		})
+	}
+}
+
+func TestListSorted(t *testing.T) {
+	t.Parallel()
+
+	fields := sortFields[int]{
+		"value": cmp.Compare[int],
+	}
+
+	testCases := map[string]struct {
+		sortBy    types.String
+		sortOrder types.String
+		opts      listOptions
+		keep      func(int) bool
+		expected  []int
+	}{
+		"unsorted-max-items": {
+			sortBy:    types.StringNull(),
+			sortOrder: types.StringNull(),
+			opts:      listOptions{PageSize: 2, MaxItems: 3},
+			expected:  []int{5, 3, 8},
+		},
+		"sorted": {
+			sortBy:    types.StringValue("value"),
+			sortOrder: types.StringNull(),
+			opts:      listOptions{PageSize: 2},
+			expected:  []int{1, 2, 3, 5, 8, 9},
+		},
+		"sorted-max-items": {
+			sortBy:    types.StringValue("value"),
+			sortOrder: types.StringNull(),
+			opts:      listOptions{PageSize: 2, MaxItems: 3},
+			expected:  []int{1, 2, 3},
+		},
+		"sorted-descending-max-items": {
+			sortBy:    types.StringValue("value"),
+			sortOrder: types.StringValue("desc"),
+			opts:      listOptions{PageSize: 2, MaxItems: 2},
+			expected:  []int{9, 8},
+		},
+		"sorted-max-items-after-keep": {
+			sortBy:    types.StringValue("value"),
+			sortOrder: types.StringNull(),
+			opts:      listOptions{PageSize: 2, MaxItems: 2},
+			keep:      func(n int) bool { return n%2 != 0 },
+			expected:  []int{1, 3},
+		},
+		"sorted-max-items-above-total": {
+			sortBy:    types.StringValue("value"),
+			sortOrder: types.StringNull(),
+			opts:      listOptions{PageSize: 2, MaxItems: 10},
+			expected:  []int{1, 2, 3, 5, 8, 9},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				items := []int{5, 3, 8, 1, 9, 2}
+
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+				start := min((page-1)*pageSize, len(items))
+
+				_ = json.NewEncoder(w).Encode(items[start:min(start+pageSize, len(items))])
+			}))
+			defer server.Close()
+
+			client := &apiClient{Client: &hashicups.Client{HostURL: server.URL, HTTPClient: server.Client()}}
+
+			got, err := listSorted(context.Background(), client, "/items", nil, testCase.opts, fields, testCase.sortBy, testCase.sortOrder, testCase.keep)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompareTimestamps(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a        string
+		b        string
+		expected int
+	}{
+		"before": {
+			a:        "2024-01-01T00:00:00Z",
+			b:        "2024-01-02T00:00:00Z",
+			expected: -1,
+		},
+		"equal-across-time-zones": {
+			a:        "2024-01-01T09:00:00+09:00",
+			b:        "2024-01-01T00:00:00Z",
+			expected: 0,
+		},
+		"after-across-time-zones": {
+			a:        "2024-01-01T01:00:00Z",
+			b:        "2024-01-01T09:30:00+09:00",
+			expected: 1,
+		},
+		"invalid": {
+			a:        "b",
+			b:        "a",
+			expected: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := compareTimestamps(testCase.a, testCase.b)
+
+			if got != testCase.expected {
+				t.Errorf("expected %d, got %d", testCase.expected, got)
+			}
+		})
+	}
+}
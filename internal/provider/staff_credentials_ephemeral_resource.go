@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultStaffCredentialsTTL is how long staff credentials stay valid
+	// when no ttl is configured.
+	defaultStaffCredentialsTTL = time.Hour
+
+	// maxStaffCredentialsTTL is the longest ttl the API accepts for staff
+	// credentials.
+	maxStaffCredentialsTTL = 24 * time.Hour
+)
+
+// staffCredentialsPrivateKey is the private data key holding the API path of
+// the credentials minted by a hashicups_staff_credentials ephemeral resource,
+// for revoking them on Close.
+const staffCredentialsPrivateKey = "credentials_path"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                   = &staffCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &staffCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &staffCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &staffCredentialsEphemeralResource{}
+)
+
+// NewStaffCredentialsEphemeralResource is a helper function to simplify the provider implementation.
+func NewStaffCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &staffCredentialsEphemeralResource{}
+}
+
+// staffCredentialsEphemeralResource is the ephemeral resource implementation.
+type staffCredentialsEphemeralResource struct {
+	client *apiClient
+}
+
+// staffCredentialsEphemeralResourceModel maps the ephemeral resource schema
+// data.
+type staffCredentialsEphemeralResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	CafeID    types.String `tfsdk:"cafe_id"`
+	Role      types.String `tfsdk:"role"`
+	TTL       types.String `tfsdk:"ttl"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// apiStaffCredentials is the API representation of temporary credentials for
+// a staff member at a cafe. The API only returns the password in the response
+// to the create request.
+type apiStaffCredentials struct {
+	ID         int    `json:"id,omitempty"`
+	Role       string `json:"role"`
+	TTLSeconds int64  `json:"ttl_seconds,omitempty"`
+	Username   string `json:"username,omitempty"`
+	Password   string `json:"password,omitempty"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *staffCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_staff_credentials"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *staffCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+			},
+			// role scopes what the credentials may do at the cafe, such as
+			// "barista" or the name of a hashicups_role.
+			"role": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			// ttl is a duration such as "30m", one hour when unset.
+			"ttl": schema.StringAttribute{
+				Optional: true,
+			},
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the ttl is a duration the API accepts.
+func (r *staffCredentialsEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var ttl types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ttl"), &ttl)...)
+	if resp.Diagnostics.HasError() || ttl.IsNull() || ttl.IsUnknown() {
+		return
+	}
+
+	if _, err := parseStaffCredentialsTTL(ttl.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ttl"),
+			"Invalid Staff Credentials TTL",
+			err.Error(),
+		)
+	}
+}
+
+// Open mints the staff credentials.
+func (r *staffCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data staffCredentialsEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cafeID := parseObjectID(path.Root("cafe_id"), data.CafeID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl := defaultStaffCredentialsTTL
+	if !data.TTL.IsNull() {
+		var err error
+		ttl, err = parseStaffCredentialsTTL(data.TTL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ttl"),
+				"Invalid Staff Credentials TTL",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	credentialsPath := "/cafes/" + strconv.Itoa(cafeID) + "/staff-credentials"
+
+	var created apiStaffCredentials
+	err := r.client.do(ctx, http.MethodPost, credentialsPath, apiStaffCredentials{
+		Role:       data.Role.ValueString(),
+		TTLSeconds: int64(ttl / time.Second),
+	}, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating HashiCups Staff Credentials",
+			"Could not create staff credentials for HashiCups cafe ID "+data.CafeID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(created.ID))
+	data.Username = types.StringValue(created.Username)
+	data.Password = types.StringValue(created.Password)
+	data.ExpiresAt = stringValueOrNull(created.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, staffCredentialsPrivateKey, credentialsPath+"/"+strconv.Itoa(created.ID))...)
+}
+
+// Close revokes the staff credentials, so that they cannot be used once
+// Terraform is done with them, even before they expire.
+func (r *staffCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	credentialsPath, diags := getPrivateString(ctx, req.Private, staffCredentialsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || credentialsPath == "" {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, credentialsPath, nil, nil)
+	// Credentials that expired in the meantime are already gone.
+	if isNotFound(err) {
+		tflog.Debug(ctx, "HashiCups staff credentials already expired", map[string]any{"path": credentialsPath})
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Revoking HashiCups Staff Credentials",
+			"Could not revoke staff credentials, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *staffCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// parseStaffCredentialsTTL parses a ttl, which must be a whole number of
+// seconds between one second and maxStaffCredentialsTTL.
+func parseStaffCredentialsTTL(s string) (time.Duration, error) {
+	ttl, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("ttl must be a duration such as \"30m\" or \"2h\", got: %q", s)
+	}
+
+	if ttl < time.Second || ttl > maxStaffCredentialsTTL || ttl%time.Second != 0 {
+		return 0, fmt.Errorf("ttl must be a whole number of seconds between 1s and %s, got: %q", maxStaffCredentialsTTL, s)
+	}
+
+	return ttl, nil
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &staffResource{}
+	_ resource.ResourceWithConfigure      = &staffResource{}
+	_ resource.ResourceWithValidateConfig = &staffResource{}
+	_ resource.ResourceWithImportState    = &staffResource{}
+)
+
+// NewStaffResource is a helper function to simplify the provider implementation.
+func NewStaffResource() resource.Resource {
+	return &staffResource{}
+}
+
+// staffResource is the resource implementation.
+type staffResource struct {
+	client *apiClient
+}
+
+// staffResourceModel maps the resource schema data.
+type staffResourceModel struct {
+	ID         types.String  `tfsdk:"id"`
+	UserID     types.String  `tfsdk:"user_id"`
+	CafeID     types.String  `tfsdk:"cafe_id"`
+	Position   types.String  `tfsdk:"position"`
+	HourlyRate decimal.Value `tfsdk:"hourly_rate"`
+}
+
+// apiStaff is the API representation of a user's assignment to a cafe as a
+// staff member.
+type apiStaff struct {
+	ID         int          `json:"id,omitempty"`
+	UserID     int          `json:"user_id"`
+	CafeID     int          `json:"cafe_id"`
+	Position   string       `json:"position"`
+	HourlyRate *json.Number `json:"hourly_rate,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *staffResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_staff"
+}
+
+// Schema defines the schema for the resource.
+func (r *staffResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"position": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			// Pay is not secret to whoever manages the staff, but is kept
+			// out of plan output and logs.
+			"hourly_rate": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Optional:   true,
+				Sensitive:  true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the hourly rate is not negative.
+func (r *staffResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var hourlyRate decimal.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("hourly_rate"), &hourlyRate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The value is sensitive, so it is not included in the error.
+	if rate, ok := knownDecimal(hourlyRate); ok && rate.Sign() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hourly_rate"),
+			"Invalid Hourly Rate",
+			"hourly_rate must not be negative.",
+		)
+	}
+}
+
+// Create a new resource.
+func (r *staffResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan staffResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staff, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created apiStaff
+	err := r.client.do(ctx, http.MethodPost, "/staff", staff, &created)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating staff member",
+			"Could not create staff member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *staffResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state staffResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var staff apiStaff
+	err := r.client.do(ctx, http.MethodGet, "/staff/"+state.ID.ValueString(), nil, &staff)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups staff member not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Staff Member",
+			"Could not read HashiCups staff member ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(staff)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *staffResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan staffResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staff, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated apiStaff
+	err := r.client.do(ctx, http.MethodPut, "/staff/"+plan.ID.ValueString(), staff, &updated)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Staff Member",
+			"Could not update staff member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *staffResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state staffResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/staff/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Staff Member Already Deleted",
+			"The staff member was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Staff Member",
+			"Could not delete staff member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *staffResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing staff member by its ID.
+func (r *staffResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m staffResourceModel) toAPI() (apiStaff, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	staff := apiStaff{
+		UserID:   parseObjectID(path.Root("user_id"), m.UserID.ValueString(), &diags),
+		CafeID:   parseObjectID(path.Root("cafe_id"), m.CafeID.ValueString(), &diags),
+		Position: m.Position.ValueString(),
+	}
+	if !m.HourlyRate.IsNull() {
+		rate := json.Number(m.HourlyRate.ValueString())
+		staff.HourlyRate = &rate
+	}
+
+	return staff, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *staffResourceModel) fromAPI(staff apiStaff) {
+	m.ID = types.StringValue(strconv.Itoa(staff.ID))
+	m.UserID = types.StringValue(strconv.Itoa(staff.UserID))
+	m.CafeID = types.StringValue(strconv.Itoa(staff.CafeID))
+	m.Position = types.StringValue(staff.Position)
+	m.HourlyRate = decimal.NewNull()
+	if staff.HourlyRate != nil {
+		m.HourlyRate = decimal.NewValue(staff.HourlyRate.String())
+	}
+}
@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &staffScheduleResource{}
+	_ resource.ResourceWithConfigure      = &staffScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &staffScheduleResource{}
+	_ resource.ResourceWithImportState    = &staffScheduleResource{}
+)
+
+// NewStaffScheduleResource is a helper function to simplify the provider implementation.
+func NewStaffScheduleResource() resource.Resource {
+	return &staffScheduleResource{}
+}
+
+// staffScheduleResource is the resource implementation. A cafe has a single
+// weekly schedule, so the resource owns all shifts of the cafe.
+type staffScheduleResource struct {
+	client *apiClient
+}
+
+// staffScheduleResourceModel maps the resource schema data.
+type staffScheduleResourceModel struct {
+	ID     types.String      `tfsdk:"id"`
+	CafeID types.String      `tfsdk:"cafe_id"`
+	Shifts []staffShiftModel `tfsdk:"shifts"`
+}
+
+// staffShiftModel maps a weekly shift of a staff member.
+type staffShiftModel struct {
+	StaffID types.String `tfsdk:"staff_id"`
+	Day     types.String `tfsdk:"day"`
+	Starts  types.String `tfsdk:"starts"`
+	Ends    types.String `tfsdk:"ends"`
+}
+
+// apiStaffSchedule is the API representation of the weekly schedule of a
+// cafe.
+type apiStaffSchedule struct {
+	Shifts []apiStaffShift `json:"shifts"`
+}
+
+// apiStaffShift is the API representation of a weekly shift. Starts and
+// Ends are in HH:MM format, in the cafe's local time.
+type apiStaffShift struct {
+	StaffID int    `json:"staff_id"`
+	Day     string `json:"day"`
+	Starts  string `json:"starts"`
+	Ends    string `json:"ends"`
+}
+
+// Metadata returns the resource type name.
+func (r *staffScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_staff_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *staffScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cafe_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// Shifts that end at or before they start end after midnight,
+			// on the following day.
+			"shifts": schema.SetNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"staff_id": schema.StringAttribute{
+							Required: true,
+						},
+						"day": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(weekdays...),
+							},
+						},
+						"starts": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.TimeOfDay(),
+							},
+						},
+						"ends": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.TimeOfDay(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that no staff member has overlapping shifts. Shifts
+// with unknown values are skipped.
+func (r *staffScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var shiftsValue types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shifts"), &shiftsValue)...)
+	if resp.Diagnostics.HasError() || shiftsValue.IsNull() || shiftsValue.IsUnknown() {
+		return
+	}
+
+	var shifts []staffShiftModel
+	resp.Diagnostics.Append(shiftsValue.ElementsAs(ctx, &shifts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, a := range shifts {
+		for _, b := range shifts[i+1:] {
+			if !a.known() || !b.known() || a.StaffID.ValueString() != b.StaffID.ValueString() {
+				continue
+			}
+
+			if shiftsOverlap(a.toAPI(), b.toAPI()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("shifts"),
+					"Overlapping Staff Shifts",
+					fmt.Sprintf("Staff ID %s has overlapping shifts on %s %s-%s and %s %s-%s.",
+						a.StaffID.ValueString(),
+						a.Day.ValueString(), a.Starts.ValueString(), a.Ends.ValueString(),
+						b.Day.ValueString(), b.Starts.ValueString(), b.Ends.ValueString(),
+					),
+				)
+			}
+		}
+	}
+}
+
+// Create a new resource.
+func (r *staffScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan staffScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Creating the resource replaces whatever schedule the cafe currently
+	// has.
+	var schedule apiStaffSchedule
+	err := r.client.do(ctx, http.MethodPut, "/cafes/"+plan.CafeID.ValueString()+"/schedule", body, &schedule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating staff schedule",
+			"Could not create staff schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.CafeID
+	plan.fromAPI(schedule)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *staffScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state staffScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var schedule apiStaffSchedule
+	err := r.client.do(ctx, http.MethodGet, "/cafes/"+state.ID.ValueString()+"/schedule", nil, &schedule)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups staff schedule not found, removing from state", map[string]any{"cafe_id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Staff Schedule",
+			"Could not read staff schedule of HashiCups cafe ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.CafeID = state.ID
+	state.fromAPI(schedule)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *staffScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan staffScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := plan.toAPI()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var schedule apiStaffSchedule
+	err := r.client.do(ctx, http.MethodPut, "/cafes/"+plan.ID.ValueString()+"/schedule", body, &schedule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Staff Schedule",
+			"Could not update staff schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(schedule)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *staffScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state staffScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/cafes/"+state.ID.ValueString()+"/schedule", nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Staff Schedule Already Deleted",
+			"The staff schedule was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Staff Schedule",
+			"Could not delete staff schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *staffScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the staff schedule of an existing cafe by the cafe ID.
+func (r *staffScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m staffScheduleResourceModel) toAPI() (apiStaffSchedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schedule := apiStaffSchedule{
+		Shifts: []apiStaffShift{},
+	}
+	for _, shift := range m.Shifts {
+		body := shift.toAPI()
+		body.StaffID = parseObjectID(path.Root("shifts"), shift.StaffID.ValueString(), &diags)
+		schedule.Shifts = append(schedule.Shifts, body)
+	}
+
+	return schedule, diags
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *staffScheduleResourceModel) fromAPI(schedule apiStaffSchedule) {
+	m.Shifts = []staffShiftModel{}
+	for _, shift := range schedule.Shifts {
+		m.Shifts = append(m.Shifts, staffShiftModel{
+			StaffID: types.StringValue(strconv.Itoa(shift.StaffID)),
+			Day:     types.StringValue(shift.Day),
+			Starts:  types.StringValue(shift.Starts),
+			Ends:    types.StringValue(shift.Ends),
+		})
+	}
+}
+
+// known reports whether all values of the shift are known.
+func (m staffShiftModel) known() bool {
+	return !m.StaffID.IsUnknown() && !m.Day.IsUnknown() && !m.Starts.IsUnknown() && !m.Ends.IsUnknown()
+}
+
+// toAPI builds the API representation of the shift, without the staff ID,
+// which is parsed by the caller.
+func (m staffShiftModel) toAPI() apiStaffShift {
+	return apiStaffShift{
+		Day:    m.Day.ValueString(),
+		Starts: m.Starts.ValueString(),
+		Ends:   m.Ends.ValueString(),
+	}
+}
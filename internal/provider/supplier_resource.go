@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/validators/int64validator"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &supplierResource{}
+	_ resource.ResourceWithConfigure   = &supplierResource{}
+	_ resource.ResourceWithImportState = &supplierResource{}
+)
+
+// NewSupplierResource is a helper function to simplify the provider implementation.
+func NewSupplierResource() resource.Resource {
+	return &supplierResource{}
+}
+
+// supplierResource is the resource implementation.
+type supplierResource struct {
+	client *apiClient
+}
+
+// supplierResourceModel maps the resource schema data.
+type supplierResourceModel struct {
+	ID           types.String          `tfsdk:"id"`
+	Name         types.String          `tfsdk:"name"`
+	Contact      *supplierContactModel `tfsdk:"contact"`
+	LeadTimeDays types.Int64           `tfsdk:"lead_time_days"`
+}
+
+// supplierContactModel maps the contact information of a supplier.
+type supplierContactModel struct {
+	Name    types.String          `tfsdk:"name"`
+	Email   types.String          `tfsdk:"email"`
+	Phone   types.String          `tfsdk:"phone"`
+	Address *supplierAddressModel `tfsdk:"address"`
+}
+
+// supplierAddressModel maps the postal address of a supplier.
+type supplierAddressModel struct {
+	Street     types.String `tfsdk:"street"`
+	City       types.String `tfsdk:"city"`
+	PostalCode types.String `tfsdk:"postal_code"`
+	Country    types.String `tfsdk:"country"`
+}
+
+// apiSupplier is the API representation of a supplier of ingredients.
+type apiSupplier struct {
+	ID           int                 `json:"id,omitempty"`
+	Name         string              `json:"name"`
+	Contact      *apiSupplierContact `json:"contact"`
+	LeadTimeDays *int64              `json:"lead_time_days,omitempty"`
+}
+
+// apiSupplierContact is the API representation of a supplier's contact
+// information. Unset fields are empty.
+type apiSupplierContact struct {
+	Name    string              `json:"name,omitempty"`
+	Email   string              `json:"email,omitempty"`
+	Phone   string              `json:"phone,omitempty"`
+	Address *apiSupplierAddress `json:"address,omitempty"`
+}
+
+// apiSupplierAddress is the API representation of a supplier's postal
+// address.
+type apiSupplierAddress struct {
+	Street     string `json:"street"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+// Metadata returns the resource type name.
+func (r *supplierResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supplier"
+}
+
+// Schema defines the schema for the resource.
+func (r *supplierResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"contact": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+					"email": schema.StringAttribute{
+						Optional: true,
+					},
+					"phone": schema.StringAttribute{
+						Optional: true,
+					},
+					"address": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"street": schema.StringAttribute{
+								Required: true,
+							},
+							"city": schema.StringAttribute{
+								Required: true,
+							},
+							"postal_code": schema.StringAttribute{
+								Optional: true,
+							},
+							"country": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			"lead_time_days": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *supplierResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan supplierResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var supplier apiSupplier
+	err := r.client.do(ctx, http.MethodPost, "/suppliers", plan.toAPI(), &supplier)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating supplier",
+			"Could not create supplier, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(supplier)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *supplierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state supplierResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var supplier apiSupplier
+	err := r.client.do(ctx, http.MethodGet, "/suppliers/"+state.ID.ValueString(), nil, &supplier)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups supplier not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Supplier",
+			"Could not read HashiCups supplier ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(supplier)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *supplierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan supplierResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var supplier apiSupplier
+	err := r.client.do(ctx, http.MethodPut, "/suppliers/"+plan.ID.ValueString(), plan.toAPI(), &supplier)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Supplier",
+			"Could not update supplier, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(supplier)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *supplierResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state supplierResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/suppliers/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Supplier Already Deleted",
+			"The supplier was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Supplier",
+			"Could not delete supplier, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *supplierResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing supplier by its ID.
+func (r *supplierResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m supplierResourceModel) toAPI() apiSupplier {
+	supplier := apiSupplier{
+		Name:         m.Name.ValueString(),
+		LeadTimeDays: m.LeadTimeDays.ValueInt64Pointer(),
+	}
+	if m.Contact != nil {
+		supplier.Contact = &apiSupplierContact{
+			Name:  m.Contact.Name.ValueString(),
+			Email: m.Contact.Email.ValueString(),
+			Phone: m.Contact.Phone.ValueString(),
+		}
+		if address := m.Contact.Address; address != nil {
+			supplier.Contact.Address = &apiSupplierAddress{
+				Street:     address.Street.ValueString(),
+				City:       address.City.ValueString(),
+				PostalCode: address.PostalCode.ValueString(),
+				Country:    address.Country.ValueString(),
+			}
+		}
+	}
+
+	return supplier
+}
+
+// fromAPI maps an API response body onto the resource model. Empty contact
+// fields are kept null.
+func (m *supplierResourceModel) fromAPI(supplier apiSupplier) {
+	m.ID = types.StringValue(strconv.Itoa(supplier.ID))
+	m.Name = types.StringValue(supplier.Name)
+	m.LeadTimeDays = types.Int64PointerValue(supplier.LeadTimeDays)
+
+	m.Contact = nil
+	if contact := supplier.Contact; contact != nil {
+		m.Contact = &supplierContactModel{
+			Name:  stringValueOrNull(contact.Name),
+			Email: stringValueOrNull(contact.Email),
+			Phone: stringValueOrNull(contact.Phone),
+		}
+		if address := contact.Address; address != nil {
+			m.Contact.Address = &supplierAddressModel{
+				Street:     types.StringValue(address.Street),
+				City:       types.StringValue(address.City),
+				PostalCode: stringValueOrNull(address.PostalCode),
+				Country:    types.StringValue(address.Country),
+			}
+		}
+	}
+}
+
+// stringValueOrNull returns s as a string value, or a null string when s is
+// empty, for optional attributes that the API returns as empty strings when
+// they are unset.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// testAccNamePrefix is the prefix of the names acceptance tests give the
+// objects they create, by which sweepers recognise leaked objects.
+const testAccNamePrefix = "tf-acc-test-"
+
+func init() {
+	resource.AddTestSweepers("inpyu_order", &resource.Sweeper{
+		Name: "inpyu_order",
+		F:    sweepOrders,
+	})
+
+	// The orders placed at a cafe are swept before it, as force_destroy
+	// deletes them before the cafe.
+	resource.AddTestSweepers("inpyu_cafe", &resource.Sweeper{
+		Name:         "inpyu_cafe",
+		Dependencies: []string{"inpyu_order"},
+		F:            sweepCafes,
+	})
+
+	resource.AddTestSweepers("inpyu_user", &resource.Sweeper{
+		Name: "inpyu_user",
+		F:    sweepUsers,
+	})
+}
+
+// sweeperClient returns a client for the HashiCups API at host, signed in
+// with HASHICUPS_TOKEN or with HASHICUPS_USERNAME and HASHICUPS_PASSWORD.
+func sweeperClient(host string) (*apiClient, error) {
+	client, err := hashicups.NewClient(&host, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if token := os.Getenv("HASHICUPS_TOKEN"); token != "" {
+		client.Token = token
+		return &apiClient{Client: client}, nil
+	}
+
+	client.Auth = hashicups.AuthStruct{
+		Username: os.Getenv("HASHICUPS_USERNAME"),
+		Password: os.Getenv("HASHICUPS_PASSWORD"),
+	}
+
+	signIn, err := client.SignIn()
+	if err != nil {
+		return nil, fmt.Errorf("signing in to %s: %w", host, err)
+	}
+	client.Token = signIn.Token
+
+	return &apiClient{Client: client}, nil
+}
+
+// testAccCafeIDs returns the IDs of the cafes acceptance tests created.
+func testAccCafeIDs(ctx context.Context, c *apiClient) (map[int]bool, error) {
+	cafes, err := listAll(ctx, c, "/cafes", nil, listOptions{}, func(cafe hashicups.Cafe) bool {
+		return strings.HasPrefix(cafe.Name, testAccNamePrefix)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[int]bool, len(cafes))
+	for _, cafe := range cafes {
+		ids[cafe.ID] = true
+	}
+
+	return ids, nil
+}
+
+// sweepOrders deletes the orders placed at cafes acceptance tests created.
+func sweepOrders(host string) error {
+	ctx := context.Background()
+
+	c, err := sweeperClient(host)
+	if err != nil {
+		return err
+	}
+
+	cafeIDs, err := testAccCafeIDs(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for cafeID := range cafeIDs {
+		orders, err := c.getCafeOrders(ctx, strconv.Itoa(cafeID))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing orders of cafe %d: %w", cafeID, err))
+			continue
+		}
+
+		for _, order := range orders {
+			log.Printf("[INFO] Deleting order %d of cafe %d", order.ID, cafeID)
+
+			if err := c.do(ctx, http.MethodDelete, "/orders/"+strconv.Itoa(order.ID), nil, nil); err != nil && !isNotFound(err) {
+				errs = append(errs, fmt.Errorf("deleting order %d: %w", order.ID, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepCafes deletes the cafes acceptance tests created.
+func sweepCafes(host string) error {
+	ctx := context.Background()
+
+	c, err := sweeperClient(host)
+	if err != nil {
+		return err
+	}
+
+	cafeIDs, err := testAccCafeIDs(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for id := range cafeIDs {
+		log.Printf("[INFO] Deleting cafe %d", id)
+
+		if err := c.deleteCafe(ctx, strconv.Itoa(id), ""); err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting cafe %d: %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepUsers deletes the users acceptance tests created.
+func sweepUsers(host string) error {
+	ctx := context.Background()
+
+	c, err := sweeperClient(host)
+	if err != nil {
+		return err
+	}
+
+	users, err := listAll(ctx, c, "/users", nil, listOptions{}, func(user apiUser) bool {
+		return strings.HasPrefix(user.Username, testAccNamePrefix)
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, user := range users {
+		log.Printf("[INFO] Deleting user %s", user.Username)
+
+		if err := c.do(ctx, http.MethodDelete, "/users/"+strconv.Itoa(user.ID), nil, nil); err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting user %s: %w", user.Username, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
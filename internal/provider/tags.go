@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTagsModel maps the provider's default_tags block.
+type defaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// mergeTags returns the effective tags of a resource: the provider's default
+// tags, overridden by the resource's own tags.
+func mergeTags(defaults, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(tags))
+	maps.Copy(merged, defaults)
+	maps.Copy(merged, tags)
+
+	return merged
+}
+
+// resourceTags returns the part of the effective tags all, as read from the
+// API, that belongs in a resource's tags attribute. Tags inherited unchanged
+// from the provider's default tags are left out unless the resource
+// configures them itself.
+func resourceTags(all, defaults, configured map[string]string) map[string]string {
+	tags := make(map[string]string, len(all))
+	for key, value := range all {
+		_, isConfigured := configured[key]
+		if defaultValue, isDefault := defaults[key]; isDefault && defaultValue == value && !isConfigured {
+			continue
+		}
+
+		tags[key] = value
+	}
+
+	return tags
+}
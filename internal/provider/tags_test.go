@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestMergeTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		defaults map[string]string
+		tags     map[string]string
+		expected map[string]string
+	}{
+		"none": {
+			expected: map[string]string{},
+		},
+		"defaults-only": {
+			defaults: map[string]string{"env": "prod"},
+			expected: map[string]string{"env": "prod"},
+		},
+		"tags-only": {
+			tags:     map[string]string{"team": "baristas"},
+			expected: map[string]string{"team": "baristas"},
+		},
+		"tags-override-defaults": {
+			defaults: map[string]string{"env": "prod", "owner": "ops"},
+			tags:     map[string]string{"env": "dev"},
+			expected: map[string]string{"env": "dev", "owner": "ops"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := mergeTags(testCase.defaults, testCase.tags); !maps.Equal(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		all        map[string]string
+		defaults   map[string]string
+		configured map[string]string
+		expected   map[string]string
+	}{
+		"inherited-default": {
+			all:      map[string]string{"env": "prod", "team": "baristas"},
+			defaults: map[string]string{"env": "prod"},
+			expected: map[string]string{"team": "baristas"},
+		},
+		"overridden-default": {
+			all:      map[string]string{"env": "dev"},
+			defaults: map[string]string{"env": "prod"},
+			expected: map[string]string{"env": "dev"},
+		},
+		"configured-same-as-default": {
+			all:        map[string]string{"env": "prod"},
+			defaults:   map[string]string{"env": "prod"},
+			configured: map[string]string{"env": "prod"},
+			expected:   map[string]string{"env": "prod"},
+		},
+		"no-defaults": {
+			all:      map[string]string{"team": "baristas"},
+			expected: map[string]string{"team": "baristas"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := resourceTags(testCase.all, testCase.defaults, testCase.configured); !maps.Equal(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-inpyu-ossca/internal/types/decimal"
+	"terraform-provider-inpyu-ossca/internal/validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &taxRateResource{}
+	_ resource.ResourceWithConfigure      = &taxRateResource{}
+	_ resource.ResourceWithValidateConfig = &taxRateResource{}
+	_ resource.ResourceWithImportState    = &taxRateResource{}
+)
+
+// NewTaxRateResource is a helper function to simplify the provider implementation.
+func NewTaxRateResource() resource.Resource {
+	return &taxRateResource{}
+}
+
+// taxRateResource is the resource implementation.
+type taxRateResource struct {
+	client *apiClient
+}
+
+// taxRateResourceModel maps the resource schema data.
+type taxRateResourceModel struct {
+	ID           types.String  `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	Percentage   decimal.Value `tfsdk:"percentage"`
+	Jurisdiction types.String  `tfsdk:"jurisdiction"`
+}
+
+// apiTaxRate is the API representation of the tax rate of a region, which
+// cafes refer to by its ID.
+type apiTaxRate struct {
+	ID           int         `json:"id,omitempty"`
+	Name         string      `json:"name"`
+	Percentage   json.Number `json:"percentage"`
+	Jurisdiction string      `json:"jurisdiction"`
+}
+
+// Metadata returns the resource type name.
+func (r *taxRateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tax_rate"
+}
+
+// Schema defines the schema for the resource.
+func (r *taxRateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			// percentage is the rate in percent, such as "8.875", kept as
+			// an exact decimal like prices.
+			"percentage": schema.StringAttribute{
+				CustomType: decimal.Type{},
+				Required:   true,
+			},
+			"jurisdiction": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the percentage is between 0 and 100.
+func (r *taxRateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var percentage decimal.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("percentage"), &percentage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if p, ok := knownDecimal(percentage); ok && (p.Sign() < 0 || p.Cmp(big.NewRat(100, 1)) > 0) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("percentage"),
+			"Invalid Tax Rate",
+			"percentage must be between 0 and 100, got: "+percentage.ValueString(),
+		)
+	}
+}
+
+// Create a new resource.
+func (r *taxRateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan taxRateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taxRate apiTaxRate
+	err := r.client.do(ctx, http.MethodPost, "/tax-rates", plan.toAPI(), &taxRate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating tax rate",
+			"Could not create tax rate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(taxRate)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *taxRateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state taxRateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taxRate apiTaxRate
+	err := r.client.do(ctx, http.MethodGet, "/tax-rates/"+state.ID.ValueString(), nil, &taxRate)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups tax rate not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups Tax Rate",
+			"Could not read HashiCups tax rate ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(taxRate)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *taxRateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan taxRateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taxRate apiTaxRate
+	err := r.client.do(ctx, http.MethodPut, "/tax-rates/"+plan.ID.ValueString(), plan.toAPI(), &taxRate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups Tax Rate",
+			"Could not update tax rate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(taxRate)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *taxRateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state taxRateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.do(ctx, http.MethodDelete, "/tax-rates/"+state.ID.ValueString(), nil, nil)
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups Tax Rate Already Deleted",
+			"The tax rate was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups Tax Rate",
+			"Could not delete tax rate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *taxRateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing tax rate by its ID.
+func (r *taxRateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m taxRateResourceModel) toAPI() apiTaxRate {
+	return apiTaxRate{
+		Name:         m.Name.ValueString(),
+		Percentage:   json.Number(m.Percentage.ValueString()),
+		Jurisdiction: m.Jurisdiction.ValueString(),
+	}
+}
+
+// fromAPI maps an API response body onto the resource model.
+func (m *taxRateResourceModel) fromAPI(taxRate apiTaxRate) {
+	m.ID = types.StringValue(strconv.Itoa(taxRate.ID))
+	m.Name = types.StringValue(taxRate.Name)
+	m.Percentage = decimal.NewValue(taxRate.Percentage.String())
+	m.Jurisdiction = types.StringValue(taxRate.Jurisdiction)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateTimeWindow adds an error diagnostic for the attribute at endsAtPath
+// when both timestamps are known RFC 3339 timestamps and endsAt is not after
+// startsAt. Malformed timestamps are reported by their attribute validators.
+func validateTimeWindow(startsAt, endsAt types.String, endsAtPath path.Path, summary string, diags *diag.Diagnostics) {
+	start, startErr := time.Parse(time.RFC3339, startsAt.ValueString())
+	end, endErr := time.Parse(time.RFC3339, endsAt.ValueString())
+	if startErr != nil || endErr != nil || end.After(start) {
+		return
+	}
+
+	diags.AddAttributeError(
+		endsAtPath,
+		summary,
+		fmt.Sprintf("The end time must be after the start time %s, got: %s", startsAt.ValueString(), endsAt.ValueString()),
+	)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateTimeWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		startsAt    types.String
+		endsAt      types.String
+		expectError bool
+	}{
+		"valid": {
+			startsAt: types.StringValue("2024-06-01T18:00:00Z"),
+			endsAt:   types.StringValue("2024-06-01T20:00:00Z"),
+		},
+		"other-offset": {
+			startsAt: types.StringValue("2024-06-01T18:00:00Z"),
+			endsAt:   types.StringValue("2024-06-01T20:30:00+02:00"),
+		},
+		"equal": {
+			startsAt:    types.StringValue("2024-06-01T18:00:00Z"),
+			endsAt:      types.StringValue("2024-06-01T18:00:00Z"),
+			expectError: true,
+		},
+		"before": {
+			startsAt:    types.StringValue("2024-06-01T18:00:00Z"),
+			endsAt:      types.StringValue("2024-06-01T17:00:00Z"),
+			expectError: true,
+		},
+		"null": {
+			startsAt: types.StringNull(),
+			endsAt:   types.StringValue("2024-06-01T17:00:00Z"),
+		},
+		"unknown": {
+			startsAt: types.StringValue("2024-06-01T18:00:00Z"),
+			endsAt:   types.StringUnknown(),
+		},
+		"malformed": {
+			startsAt: types.StringValue("tomorrow"),
+			endsAt:   types.StringValue("2024-06-01T17:00:00Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			validateTimeWindow(testCase.startsAt, testCase.endsAt, path.Root("ends_at"), "Invalid Period", &diags)
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+		})
+	}
+}
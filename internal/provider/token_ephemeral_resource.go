@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// tokenRenewBefore is how long before a token expires it is renewed, to
+// leave room for the latency of the renew request.
+const tokenRenewBefore = time.Minute
+
+// tokenPrivateKey is the private data key holding the token of a
+// hashicups_token ephemeral resource, for renewing it and signing it out.
+const tokenPrivateKey = "token"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                   = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew          = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &tokenEphemeralResource{}
+)
+
+// NewTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &tokenEphemeralResource{}
+}
+
+// tokenEphemeralResource is the ephemeral resource implementation.
+type tokenEphemeralResource struct {
+	client *apiClient
+}
+
+// tokenEphemeralResourceModel maps the ephemeral resource schema data.
+type tokenEphemeralResourceModel struct {
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	UserID    types.String `tfsdk:"user_id"`
+	Token     types.String `tfsdk:"token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// apiSignIn is the API response to signing in. ExpiresAt is empty for tokens
+// that do not expire.
+type apiSignIn struct {
+	UserID    int    `json:"user_id"`
+	Username  string `json:"username"`
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// apiTokenRenewal is the API response to renewing a token.
+type apiTokenRenewal struct {
+	ExpiresAt string `json:"expires_at"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *tokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *tokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// Without username and password, the token is signed in with
+			// the provider's own credentials.
+			"username": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"user_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			// expires_at is null for tokens that do not expire.
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig requires username and password to be set together.
+func (r *tokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var username, password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username"), &username)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() || username.IsUnknown() || password.IsUnknown() {
+		return
+	}
+
+	if username.IsNull() != password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing HashiCups Credentials",
+			"username and password must be set together, or both left unset to sign in with the provider's credentials.",
+		)
+	}
+}
+
+// Open signs in to HashiCups and returns the new token.
+func (r *tokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data tokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials := r.client.Auth
+	if !data.Username.IsNull() {
+		credentials = hashicups.AuthStruct{
+			Username: data.Username.ValueString(),
+			Password: data.Password.ValueString(),
+		}
+	}
+	if credentials.Username == "" || credentials.Password == "" {
+		resp.Diagnostics.AddError(
+			"Missing HashiCups Credentials",
+			"Set username and password to sign in, as the provider is not configured with a username and password of its own.",
+		)
+		return
+	}
+
+	var signIn apiSignIn
+	err := r.client.do(ctx, http.MethodPost, "/signin", credentials, &signIn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Sign In to HashiCups",
+			"Could not exchange the username and password for a HashiCups API token: "+err.Error(),
+		)
+		return
+	}
+
+	data.Username = types.StringValue(signIn.Username)
+	data.UserID = types.StringValue(strconv.Itoa(signIn.UserID))
+	data.Token = types.StringValue(signIn.Token)
+	data.ExpiresAt = stringValueOrNull(signIn.ExpiresAt)
+
+	resp.RenewAt, err = tokenRenewAt(signIn.ExpiresAt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected HashiCups Token Expiry",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, tokenPrivateKey, signIn.Token)...)
+}
+
+// Renew extends the lifetime of the token, which Terraform only does for
+// tokens that expire.
+func (r *tokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	token, diags := getPrivateString(ctx, req.Private, tokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var renewal apiTokenRenewal
+	err := r.client.withToken(token).do(ctx, http.MethodPost, "/token/renew", nil, &renewal)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Renew HashiCups Token",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.RenewAt, err = tokenRenewAt(renewal.ExpiresAt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected HashiCups Token Expiry",
+			err.Error(),
+		)
+	}
+}
+
+// Close signs the token out, so that it cannot be used once Terraform is
+// done with it.
+func (r *tokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	token, diags := getPrivateString(ctx, req.Private, tokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || token == "" {
+		return
+	}
+
+	err := r.client.withToken(token).do(ctx, http.MethodPost, "/signout", nil, nil)
+	// A token that expired in the meantime is already unusable.
+	var apiErr *apiError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized {
+		tflog.Debug(ctx, "HashiCups token already expired, not signing it out")
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Sign Out of HashiCups",
+			"Could not revoke the HashiCups API token: "+err.Error(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *tokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// tokenRenewAt returns when a token expiring at expiresAt, an RFC 3339
+// timestamp, is to be renewed. It returns the zero time, which Terraform
+// takes as never, when expiresAt is empty.
+func tokenRenewAt(expiresAt string) (time.Time, error) {
+	if expiresAt == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("the API returned an invalid token expiry %q: %w", expiresAt, err)
+	}
+
+	return t.Add(-tokenRenewBefore), nil
+}
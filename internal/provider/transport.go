@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// defaultRequestTimeout bounds a single HTTP request when the provider
+// configuration does not set request_timeout. It matches the timeout of the
+// hashicups-client-go client.
+const defaultRequestTimeout = 10 * time.Second
+
+// transportConfig holds the provider settings that shape the HTTP transport
+// used for HashiCups API calls.
+type transportConfig struct {
+	// ClientCertPEM and ClientKeyPEM are the PEM encoded certificate and
+	// private key presented to endpoints requiring mutual TLS.
+	ClientCertPEM string
+	ClientKeyPEM  string
+
+	// CACertPEM is a PEM encoded bundle of certificate authorities trusted
+	// in addition to the system roots.
+	CACertPEM string
+
+	// Insecure disables verification of the API server's certificate.
+	Insecure bool
+
+	// ProxyURL is the proxy all API requests are sent through. When empty,
+	// the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply.
+	ProxyURL string
+}
+
+// newTransport builds the base HTTP transport for the HashiCups client from
+// the provider's transport settings.
+func newTransport(config transportConfig) (*http.Transport, error) {
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected default HTTP transport type: %T", http.DefaultTransport)
+	}
+	// The default transport already uses http.ProxyFromEnvironment.
+	transport := defaultTransport.Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: expected an absolute URL such as http://proxy.example.com:3128", config.ProxyURL)
+		}
+
+		// NO_PROXY is still honored so hosts excluded from proxying by the
+		// environment keep being reached directly.
+		proxyConfig := httpproxy.FromEnvironment()
+		proxyConfig.HTTPProxy = proxyURL.String()
+		proxyConfig.HTTPSProxy = proxyURL.String()
+		proxyFunc := proxyConfig.ProxyFunc()
+
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.Insecure,
+	}
+
+	if config.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, errors.New("loading CA bundle: no PEM encoded certificates found")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// timeoutTransport is an http.RoundTripper that bounds every request it sends
+// with a context deadline, from sending the request until its response body
+// is closed.
+type timeoutTransport struct {
+	Base    http.RoundTripper
+	Timeout time.Duration
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+
+	res, err := t.Base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+
+	return res, nil
+}
+
+// cancelOnCloseBody releases a request's context once its response body has
+// been closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
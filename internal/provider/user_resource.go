@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithConfigure   = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+)
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userResource is the resource implementation.
+type userResource struct {
+	client *apiClient
+}
+
+// userResourceModel maps the resource schema data.
+type userResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+	Role     types.String `tfsdk:"role"`
+	Password types.String `tfsdk:"password"`
+}
+
+// apiUser is the API representation of a HashiCups user account. The API
+// accepts a password on create and update but never returns it.
+type apiUser struct {
+	ID       int    `json:"id,omitempty"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Role     string `json:"role,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+			},
+			"email": schema.StringAttribute{
+				Required: true,
+			},
+			"role": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user apiUser
+	etag, err := r.client.doConditional(ctx, http.MethodPost, "/users", "", plan.toAPI(), &user)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user",
+			"Could not create user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(user)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+// Read resource information.
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user apiUser
+	etag, err := r.client.doConditional(ctx, http.MethodGet, "/users/"+state.ID.ValueString(), "", nil, &user)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "HashiCups user not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HashiCups User",
+			"Could not read HashiCups user ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromAPI(user)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifMatch, diags := getPrivateString(ctx, req.Private, etagPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user apiUser
+	etag, err := r.client.doConditional(ctx, http.MethodPut, "/users/"+plan.ID.ValueString(), ifMatch, plan.toAPI(), &user)
+	if isPreconditionFailed(err) {
+		resp.Diagnostics.AddError(
+			"HashiCups User Modified Outside Terraform",
+			"The user was changed since Terraform last read it, so the update was rejected to avoid overwriting those changes. "+
+				"Refresh the state, review the differences and apply again.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating HashiCups User",
+			"Could not update user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.fromAPI(user)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateString(ctx, resp.Private, etagPrivateKey, etag)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifMatch, diags := getPrivateString(ctx, req.Private, etagPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.doConditional(ctx, http.MethodDelete, "/users/"+state.ID.ValueString(), ifMatch, nil, nil)
+	if isPreconditionFailed(err) {
+		resp.Diagnostics.AddError(
+			"HashiCups User Modified Outside Terraform",
+			"The user was changed since Terraform last read it, so the delete was rejected. "+
+				"Refresh the state, review the differences and apply again.",
+		)
+		return
+	}
+	if isNotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"HashiCups User Already Deleted",
+			"The user was not found and has been removed from state. It was most likely deleted outside of Terraform.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting HashiCups User",
+			"Could not delete user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports an existing user by its ID. The password cannot be
+// read back from the API, so it is unset until the next apply.
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI builds the API request body from the resource model.
+func (m userResourceModel) toAPI() apiUser {
+	return apiUser{
+		Username: m.Username.ValueString(),
+		Email:    m.Email.ValueString(),
+		Role:     m.Role.ValueString(),
+		Password: m.Password.ValueString(),
+	}
+}
+
+// fromAPI maps an API response body onto the resource model. The password
+// is left untouched as the API never returns it.
+func (m *userResourceModel) fromAPI(user apiUser) {
+	m.ID = types.StringValue(strconv.Itoa(user.ID))
+	m.Username = types.StringValue(user.Username)
+	m.Email = types.StringValue(user.Email)
+	m.Role = types.StringValue(user.Role)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *apiClient
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	Role      types.String `tfsdk:"role"`
+	Email     types.String `tfsdk:"email"`
+	MaxItems  types.Int64  `tfsdk:"max_items"`
+	PageSize  types.Int64  `tfsdk:"page_size"`
+	SortBy    types.String `tfsdk:"sort_by"`
+	SortOrder types.String `tfsdk:"sort_order"`
+	Users     []usersModel `tfsdk:"users"`
+}
+
+// usersModel maps users schema data.
+type usersModel struct {
+	ID       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+	Role     types.String `tfsdk:"role"`
+}
+
+// usersSortFields are the fields the users can be sorted by.
+var usersSortFields = sortFields[apiUser]{
+	"id": func(a, b apiUser) int {
+		return cmp.Compare(a.ID, b.ID)
+	},
+	"username": func(a, b apiUser) int {
+		return cmp.Compare(a.Username, b.Username)
+	},
+	"email": func(a, b apiUser) int {
+		return cmp.Compare(a.Email, b.Email)
+	},
+	"role": func(a, b apiUser) int {
+		return cmp.Compare(a.Role, b.Role)
+	},
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// role and email limit users to those with exactly this role
+			// and email address.
+			"role": schema.StringAttribute{
+				Optional: true,
+			},
+			"email": schema.StringAttribute{
+				Optional: true,
+			},
+			"max_items":  maxItemsAttribute(),
+			"page_size":  pageSizeAttribute(),
+			"sort_by":    sortByAttribute(usersSortFields),
+			"sort_order": sortOrderAttribute(),
+			"users": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"role": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state usersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := listSorted(ctx, d.client, "/users", nil, newListOptions(state.MaxItems, state.PageSize), usersSortFields, state.SortBy, state.SortOrder, func(user apiUser) bool {
+		return (state.Role.IsNull() || user.Role == state.Role.ValueString()) &&
+			(state.Email.IsNull() || user.Email == state.Email.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read HashiCups Users",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Users = []usersModel{}
+	for _, user := range users {
+		state.Users = append(state.Users, usersModel{
+			ID:       types.StringValue(strconv.Itoa(user.ID)),
+			Username: types.StringValue(user.Username),
+			Email:    types.StringValue(user.Email),
+			Role:     stringValueOrNull(user.Role),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
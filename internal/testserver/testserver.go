@@ -0,0 +1,476 @@
+// Package testserver implements the subset of the HashiCups API that the
+// provider uses for cafes, coffees and orders, backed by in-memory storage.
+// Acceptance tests run against it so that they need neither the
+// docker-compose stack nor a shared backend.
+//
+// Objects are stored as the JSON documents they were created with, so
+// fields the server does not know about round-trip unchanged. Cafes carry an
+// ETag that conditional updates and deletes are checked against, and cafe
+// creation honours the Idempotency-Key header, as the real API does.
+package testserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// The credentials the server accepts. Signing in as Username with Password
+// returns Token, which every other request must carry.
+const (
+	Username = "testacc"
+	Password = "testacc-password"
+	Token    = "testserver-token"
+)
+
+// object is a stored JSON document.
+type object = map[string]any
+
+// record is a stored object and its version, from which its ETag is derived.
+type record struct {
+	object  object
+	version int
+}
+
+// id returns the ID of the record.
+func (r *record) id() int {
+	id, _ := r.object["id"].(int)
+	return id
+}
+
+// etag returns the ETag of the record.
+func (r *record) etag() string {
+	return strconv.Quote(strconv.Itoa(r.version))
+}
+
+// Server is an in-memory HashiCups API served over HTTP.
+type Server struct {
+	*httptest.Server
+
+	mu              sync.Mutex
+	nextID          int
+	cafes           map[int]*record
+	coffees         map[int]*record
+	orders          map[int]*record
+	idempotencyKeys map[string]int
+}
+
+// New starts a server holding the coffees of the HashiCups demo database.
+// The caller should call Close when finished to shut it down.
+func New() *Server {
+	s := &Server{
+		nextID:          1,
+		cafes:           map[int]*record{},
+		coffees:         map[int]*record{},
+		orders:          map[int]*record{},
+		idempotencyKeys: map[string]int{},
+	}
+
+	for _, coffee := range demoCoffees {
+		s.AddCoffee(coffee)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /signin", s.signIn)
+	mux.HandleFunc("GET /cafes", s.list(s.cafes))
+	mux.HandleFunc("POST /cafes", s.createCafe)
+	mux.HandleFunc("GET /cafes/{id}", s.getCafe)
+	mux.HandleFunc("PUT /cafes/{id}", s.updateCafe)
+	mux.HandleFunc("DELETE /cafes/{id}", s.deleteCafe)
+	mux.HandleFunc("GET /cafes/{id}/orders", s.listCafeOrders)
+	mux.HandleFunc("GET /coffees", s.list(s.coffees))
+	mux.HandleFunc("POST /coffees", s.createCoffee)
+	mux.HandleFunc("GET /coffees/{id}", s.getCoffee)
+	mux.HandleFunc("GET /orders", s.list(s.orders))
+	mux.HandleFunc("POST /orders", s.createOrder)
+	mux.HandleFunc("GET /orders/{id}", s.getOrder)
+	mux.HandleFunc("PUT /orders/{id}", s.updateOrder)
+	mux.HandleFunc("DELETE /orders/{id}", s.deleteOrder)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+
+	return s
+}
+
+// demoCoffees are the coffees of the HashiCups demo database, which
+// configurations written against the docker-compose stack refer to by ID.
+var demoCoffees = []hashicups.Coffee{
+	{Name: "Packer Spiced Latte", Teaser: "Packed with goodness to spice up your images", Price: 350, Image: "/packer.png"},
+	{Name: "Vaulatte", Teaser: "Nothing gives you a safe and secure feeling like a Vaulatte", Price: 200, Image: "/vault.png"},
+	{Name: "Nomadicano", Teaser: "Drink one today and you will want to schedule another", Price: 150, Image: "/nomad.png"},
+	{Name: "Terraspresso", Teaser: "Nothing kickstarts your day like a provision of Terraspresso", Price: 150, Image: "/terraform.png"},
+	{Name: "Vagrante espresso", Teaser: "Stdin is not a tty", Price: 200, Image: "/vagrant.png"},
+	{Name: "Boundary Red Eye", Teaser: "Perk up and watch out for your access management", Price: 200, Image: "/boundary.png"},
+	{Name: "Waypointiato", Teaser: "Deploy with a little foam", Price: 250, Image: "/waypoint.png"},
+}
+
+// AddCafe stores cafe, ignoring its ID, and returns the ID it was given.
+func (s *Server) AddCafe(cafe hashicups.Cafe) int {
+	return s.add(s.cafes, cafe)
+}
+
+// AddCoffee stores coffee, ignoring its ID, and returns the ID it was given.
+func (s *Server) AddCoffee(coffee hashicups.Coffee) int {
+	return s.add(s.coffees, coffee)
+}
+
+// add stores v in records under a new ID and returns the ID.
+func (s *Server) add(records map[int]*record, v any) int {
+	var obj object
+	if err := remarshal(v, &obj); err != nil {
+		panic(fmt.Sprintf("testserver: storing %T: %s", v, err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.insert(records, obj).id()
+}
+
+// insert stores obj in records under a new ID. The caller must hold s.mu.
+func (s *Server) insert(records map[int]*record, obj object) *record {
+	obj["id"] = s.nextID
+	rec := &record{object: obj, version: 1}
+	records[s.nextID] = rec
+	s.nextID++
+
+	return rec
+}
+
+// authenticate rejects requests other than sign-in that do not carry Token.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/signin" && r.Header.Get("Authorization") != Token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) signIn(w http.ResponseWriter, r *http.Request) {
+	var auth hashicups.AuthStruct
+	if err := json.NewDecoder(r.Body).Decode(&auth); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if auth.Username != Username || auth.Password != Password {
+		http.Error(w, "invalid credentials", http.StatusUnauthorized)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, hashicups.AuthResponse{UserID: 1, Username: Username, Token: Token})
+}
+
+// list returns a handler listing records by ID. Like the real API, it
+// returns one page of the list when the page_size query parameter is set.
+func (s *Server) list(records map[int]*record) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		items := s.sorted(records, func(object) bool { return true })
+
+		if pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size")); err == nil && pageSize > 0 {
+			page, err := strconv.Atoi(r.URL.Query().Get("page"))
+			if err != nil || page < 1 {
+				page = 1
+			}
+
+			start := min((page-1)*pageSize, len(items))
+			items = items[start:min(start+pageSize, len(items))]
+		}
+
+		writeJSON(w, http.StatusOK, items)
+	}
+}
+
+// sorted returns the objects in records that match keep, ordered by ID. The
+// caller must hold s.mu.
+func (s *Server) sorted(records map[int]*record, keep func(object) bool) []object {
+	ids := make([]int, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	items := []object{}
+	for _, id := range ids {
+		if obj := records[id].object; keep(obj) {
+			items = append(items, obj)
+		}
+	}
+
+	return items
+}
+
+func (s *Server) createCafe(w http.ResponseWriter, r *http.Request) {
+	obj, ok := decodeSingle(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := r.Header.Get("Idempotency-Key")
+	if id, ok := s.idempotencyKeys[key]; ok && key != "" {
+		if rec, ok := s.cafes[id]; ok {
+			w.Header().Set("ETag", rec.etag())
+			writeJSON(w, http.StatusOK, rec.object)
+			return
+		}
+	}
+
+	rec := s.insert(s.cafes, obj)
+	if key != "" {
+		s.idempotencyKeys[key] = rec.id()
+	}
+
+	w.Header().Set("ETag", rec.etag())
+	writeJSON(w, http.StatusOK, rec.object)
+}
+
+func (s *Server) getCafe(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.find(w, r, s.cafes)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("ETag", rec.etag())
+	writeJSON(w, http.StatusOK, []object{rec.object})
+}
+
+func (s *Server) updateCafe(w http.ResponseWriter, r *http.Request) {
+	obj, ok := decodeSingle(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.find(w, r, s.cafes)
+	if !ok || !checkIfMatch(w, r, rec) {
+		return
+	}
+
+	obj["id"] = rec.id()
+	rec.object = obj
+	rec.version++
+
+	w.Header().Set("ETag", rec.etag())
+	writeJSON(w, http.StatusOK, rec.object)
+}
+
+func (s *Server) deleteCafe(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.find(w, r, s.cafes)
+	if !ok || !checkIfMatch(w, r, rec) {
+		return
+	}
+
+	delete(s.cafes, rec.id())
+
+	fmt.Fprint(w, "Deleted cafe")
+}
+
+func (s *Server) listCafeOrders(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.find(w, r, s.cafes)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.sorted(s.orders, func(order object) bool {
+		cafeID, _ := order["cafe_id"].(float64)
+		return int(cafeID) == rec.id()
+	}))
+}
+
+func (s *Server) createCoffee(w http.ResponseWriter, r *http.Request) {
+	var obj object
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, s.insert(s.coffees, obj).object)
+}
+
+func (s *Server) getCoffee(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.find(w, r, s.coffees)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, []object{rec.object})
+}
+
+func (s *Server) createOrder(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items, ok := s.decodeOrderItems(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.insert(s.orders, object{"items": items}).object)
+}
+
+func (s *Server) getOrder(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.find(w, r, s.orders)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rec.object)
+}
+
+func (s *Server) updateOrder(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.find(w, r, s.orders)
+	if !ok {
+		return
+	}
+
+	items, ok := s.decodeOrderItems(w, r)
+	if !ok {
+		return
+	}
+
+	rec.object["items"] = items
+	rec.version++
+
+	writeJSON(w, http.StatusOK, rec.object)
+}
+
+func (s *Server) deleteOrder(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.find(w, r, s.orders)
+	if !ok {
+		return
+	}
+
+	delete(s.orders, rec.id())
+
+	fmt.Fprint(w, "Deleted order")
+}
+
+// decodeOrderItems decodes the items of an order from the request body and
+// replaces the coffee of each with the stored coffee it refers to, as the
+// real API does. It writes an error response and returns false when the
+// body is invalid or refers to an unknown coffee. The caller must hold s.mu.
+func (s *Server) decodeOrderItems(w http.ResponseWriter, r *http.Request) ([]object, bool) {
+	var items []hashicups.OrderItem
+	if err := json.NewDecoder(r.Body).Decode(&items); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	expanded := make([]object, 0, len(items))
+	for _, item := range items {
+		coffee, ok := s.coffees[item.Coffee.ID]
+		if !ok {
+			http.Error(w, fmt.Sprintf("coffee %d not found", item.Coffee.ID), http.StatusBadRequest)
+			return nil, false
+		}
+
+		expanded = append(expanded, object{"coffee": coffee.object, "quantity": item.Quantity})
+	}
+
+	return expanded, true
+}
+
+// find returns the record in records with the ID in the request path. It
+// writes a 404 response and returns false when there is none. The caller
+// must hold s.mu.
+func (s *Server) find(w http.ResponseWriter, r *http.Request, records map[int]*record) (*record, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.NotFound(w, r)
+		return nil, false
+	}
+
+	rec, ok := records[id]
+	if !ok {
+		http.NotFound(w, r)
+		return nil, false
+	}
+
+	return rec, true
+}
+
+// checkIfMatch writes a 412 response and returns false when the request has
+// an If-Match header that does not match the ETag of rec.
+func checkIfMatch(w http.ResponseWriter, r *http.Request, rec *record) bool {
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" && ifMatch != rec.etag() {
+		http.Error(w, "etag mismatch", http.StatusPreconditionFailed)
+		return false
+	}
+
+	return true
+}
+
+// decodeSingle decodes a request body holding a list of one object, which is
+// how the API takes cafes. It writes a 400 response and returns false when
+// the body is not such a list.
+func decodeSingle(w http.ResponseWriter, r *http.Request) (object, bool) {
+	var objs []object
+	err := json.NewDecoder(r.Body).Decode(&objs)
+	if err == nil && len(objs) != 1 {
+		err = errors.New("expected a list of one object")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	return objs[0], true
+}
+
+// remarshal converts in to out by way of JSON.
+func remarshal(in, out any) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,307 @@
+package testserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/inpyu/hashicups-client-go"
+)
+
+// newClient returns a HashiCups client signed in to s.
+func newClient(t *testing.T, s *Server) *hashicups.Client {
+	t.Helper()
+
+	username, password := Username, Password
+	client, err := hashicups.NewClient(&s.URL, &username, &password)
+	if err != nil {
+		t.Fatalf("unexpected error signing in: %s", err)
+	}
+
+	return client
+}
+
+func TestServerSignIn(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		username    string
+		password    string
+		expectError bool
+	}{
+		"valid": {
+			username: Username,
+			password: Password,
+		},
+		"wrong-password": {
+			username:    Username,
+			password:    "wrong",
+			expectError: true,
+		},
+	}
+
+	s := New()
+	t.Cleanup(s.Close)
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := hashicups.NewClient(&s.URL, &testCase.username, &testCase.password)
+
+			if got := err != nil; got != testCase.expectError {
+				t.Errorf("expected error %t, got: %v", testCase.expectError, err)
+			}
+			if err == nil && client.Token != Token {
+				t.Errorf("expected token %q, got %q", Token, client.Token)
+			}
+		})
+	}
+}
+
+func TestServerUnauthenticated(t *testing.T) {
+	t.Parallel()
+
+	s := New()
+	t.Cleanup(s.Close)
+
+	client, err := hashicups.NewClient(&s.URL, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.GetCafes(); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected a 401 error, got: %v", err)
+	}
+}
+
+func TestServerCafes(t *testing.T) {
+	t.Parallel()
+
+	s := New()
+	t.Cleanup(s.Close)
+	client := newClient(t, s)
+
+	created, err := client.CreateCafe([]hashicups.Cafe{{Name: "Cafe North", Address: "1 Main St"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating cafe: %s", err)
+	}
+	id := strconv.Itoa(created.ID)
+
+	if _, err := client.UpdateCafe(id, []hashicups.Cafe{{Name: "Cafe South", Address: "1 Main St"}}); err != nil {
+		t.Fatalf("unexpected error updating cafe: %s", err)
+	}
+
+	cafes, err := client.GetCafe(id)
+	if err != nil {
+		t.Fatalf("unexpected error reading cafe: %s", err)
+	}
+	if len(cafes) != 1 || cafes[0].ID != created.ID || cafes[0].Name != "Cafe South" {
+		t.Errorf("expected cafe %d named %q, got: %+v", created.ID, "Cafe South", cafes)
+	}
+
+	if err := client.DeleteCafe(id); err != nil {
+		t.Fatalf("unexpected error deleting cafe: %s", err)
+	}
+	if _, err := client.GetCafe(id); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a 404 error, got: %v", err)
+	}
+}
+
+func TestServerCafeConditionalRequests(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method         string
+		ifMatch        string
+		expectedStatus int
+	}{
+		"update-matching": {
+			method:         http.MethodPut,
+			ifMatch:        `"1"`,
+			expectedStatus: http.StatusOK,
+		},
+		"update-stale": {
+			method:         http.MethodPut,
+			ifMatch:        `"2"`,
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		"update-unconditional": {
+			method:         http.MethodPut,
+			expectedStatus: http.StatusOK,
+		},
+		"delete-stale": {
+			method:         http.MethodDelete,
+			ifMatch:        `"2"`,
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		"delete-matching": {
+			method:         http.MethodDelete,
+			ifMatch:        `"1"`,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := New()
+			t.Cleanup(s.Close)
+			id := s.AddCafe(hashicups.Cafe{Name: "Cafe North"})
+
+			req, err := http.NewRequest(testCase.method, s.URL+"/cafes/"+strconv.Itoa(id), strings.NewReader(`[{"name": "Cafe South"}]`))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			req.Header.Set("Authorization", Token)
+			if testCase.ifMatch != "" {
+				req.Header.Set("If-Match", testCase.ifMatch)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != testCase.expectedStatus {
+				t.Errorf("expected status %d, got %d", testCase.expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestServerCafeIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	s := New()
+	t.Cleanup(s.Close)
+
+	for range 2 {
+		req, err := http.NewRequest(http.MethodPost, s.URL+"/cafes", strings.NewReader(`[{"name": "Cafe North"}]`))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		req.Header.Set("Authorization", Token)
+		req.Header.Set("Idempotency-Key", "create-cafe-north")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+	}
+
+	client := newClient(t, s)
+	cafes, err := client.GetCafes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(cafes) != 1 {
+		t.Errorf("expected 1 cafe, got %d: %+v", len(cafes), cafes)
+	}
+}
+
+func TestServerOrders(t *testing.T) {
+	t.Parallel()
+
+	s := New()
+	t.Cleanup(s.Close)
+	client := newClient(t, s)
+
+	order, err := client.CreateOrder([]hashicups.OrderItem{{Coffee: hashicups.Coffee{ID: 1}, Quantity: 2}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error creating order: %s", err)
+	}
+	if len(order.Items) != 1 || order.Items[0].Coffee.Name != demoCoffees[0].Name {
+		t.Errorf("expected the order to hold %q, got: %+v", demoCoffees[0].Name, order.Items)
+	}
+	id := strconv.Itoa(order.ID)
+
+	if _, err := client.UpdateOrder(id, []hashicups.OrderItem{{Coffee: hashicups.Coffee{ID: 2}, Quantity: 1}}, nil); err != nil {
+		t.Fatalf("unexpected error updating order: %s", err)
+	}
+
+	order, err = client.GetOrder(id, nil)
+	if err != nil {
+		t.Fatalf("unexpected error reading order: %s", err)
+	}
+	if len(order.Items) != 1 || order.Items[0].Coffee.ID != 2 || order.Items[0].Quantity != 1 {
+		t.Errorf("expected one of coffee 2, got: %+v", order.Items)
+	}
+
+	if _, err := client.CreateOrder([]hashicups.OrderItem{{Coffee: hashicups.Coffee{ID: 999}, Quantity: 1}}, nil); err == nil {
+		t.Errorf("expected an error ordering an unknown coffee")
+	}
+
+	if err := client.DeleteOrder(id, nil); err != nil {
+		t.Fatalf("unexpected error deleting order: %s", err)
+	}
+	if _, err := client.GetOrder(id, nil); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a 404 error, got: %v", err)
+	}
+}
+
+func TestServerListPagination(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		query    string
+		expected int
+	}{
+		"unpaginated": {
+			query:    "",
+			expected: len(demoCoffees),
+		},
+		"first-page": {
+			query:    "?page=1&page_size=3",
+			expected: 3,
+		},
+		"last-page": {
+			query:    "?page=3&page_size=3",
+			expected: len(demoCoffees) - 6,
+		},
+		"past-the-end": {
+			query:    "?page=5&page_size=3",
+			expected: 0,
+		},
+	}
+
+	s := New()
+	t.Cleanup(s.Close)
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequest(http.MethodGet, s.URL+"/coffees"+testCase.query, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			req.Header.Set("Authorization", Token)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer resp.Body.Close()
+
+			var coffees []hashicups.Coffee
+			if err := json.NewDecoder(resp.Body).Decode(&coffees); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(coffees) != testCase.expected {
+				t.Errorf("expected %d coffees, got %d", testCase.expected, len(coffees))
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Package timeouts provides the `timeouts` block that lets practitioners
+// bound how long a resource operation may take. It follows the shape of
+// terraform-plugin-framework-timeouts: a resource adds Block to its schema,
+// keeps the block in a types.Object model field, and reads the configured
+// durations with Create, Read, Update and Delete.
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	attributeNameCreate = "create"
+	attributeNameRead   = "read"
+	attributeNameUpdate = "update"
+	attributeNameDelete = "delete"
+)
+
+// Opts selects which operations the timeouts block accepts a duration for.
+type Opts struct {
+	Create bool
+	Read   bool
+	Update bool
+	Delete bool
+}
+
+// Block returns the `timeouts` block for a resource schema.
+func Block(_ context.Context, opts Opts) schema.Block {
+	attributes := map[string]schema.Attribute{}
+
+	for name, enabled := range map[string]bool{
+		attributeNameCreate: opts.Create,
+		attributeNameRead:   opts.Read,
+		attributeNameUpdate: opts.Update,
+		attributeNameDelete: opts.Delete,
+	} {
+		if !enabled {
+			continue
+		}
+
+		attributes[name] = schema.StringAttribute{
+			Optional: true,
+			Description: fmt.Sprintf("A duration such as \"30s\" or \"2h45m\" after which the %s operation is cancelled. "+
+				"Valid time units are \"s\" (seconds), \"m\" (minutes) and \"h\" (hours).", name),
+			Validators: []validator.String{
+				durationValidator{},
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Attributes: attributes,
+	}
+}
+
+// Create returns the create timeout set in the timeouts block, or def when
+// none is configured.
+func Create(ctx context.Context, timeouts types.Object, def time.Duration) (time.Duration, diag.Diagnostics) {
+	return duration(ctx, timeouts, attributeNameCreate, def)
+}
+
+// Read returns the read timeout set in the timeouts block, or def when none
+// is configured.
+func Read(ctx context.Context, timeouts types.Object, def time.Duration) (time.Duration, diag.Diagnostics) {
+	return duration(ctx, timeouts, attributeNameRead, def)
+}
+
+// Update returns the update timeout set in the timeouts block, or def when
+// none is configured.
+func Update(ctx context.Context, timeouts types.Object, def time.Duration) (time.Duration, diag.Diagnostics) {
+	return duration(ctx, timeouts, attributeNameUpdate, def)
+}
+
+// Delete returns the delete timeout set in the timeouts block, or def when
+// none is configured.
+func Delete(ctx context.Context, timeouts types.Object, def time.Duration) (time.Duration, diag.Diagnostics) {
+	return duration(ctx, timeouts, attributeNameDelete, def)
+}
+
+func duration(_ context.Context, timeouts types.Object, name string, def time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return def, diags
+	}
+
+	value, ok := timeouts.Attributes()[name].(types.String)
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return def, diags
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("timeouts").AtName(name),
+			"Invalid Timeout Duration",
+			fmt.Sprintf("Unable to parse the %s timeout %q: %s", name, value.ValueString(), err),
+		)
+		return def, diags
+	}
+
+	return d, diags
+}
+
+// durationValidator checks that a string is a positive Go duration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as \"30s\" or \"2h45m\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timeout Duration",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
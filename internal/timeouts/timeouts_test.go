@@ -0,0 +1,233 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// attributeTypes are the attribute types of a timeouts block that accepts
+// every operation.
+var attributeTypes = map[string]attr.Type{
+	attributeNameCreate: types.StringType,
+	attributeNameRead:   types.StringType,
+	attributeNameUpdate: types.StringType,
+	attributeNameDelete: types.StringType,
+}
+
+// timeoutsValue returns a timeouts block with the given durations set and
+// the other operations null.
+func timeoutsValue(durations map[string]string) types.Object {
+	values := map[string]attr.Value{}
+	for name := range attributeTypes {
+		values[name] = types.StringNull()
+		if d, ok := durations[name]; ok {
+			values[name] = types.StringValue(d)
+		}
+	}
+
+	return types.ObjectValueMust(attributeTypes, values)
+}
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     Opts
+		expected []string
+	}{
+		"none": {
+			opts:     Opts{},
+			expected: nil,
+		},
+		"create-delete": {
+			opts:     Opts{Create: true, Delete: true},
+			expected: []string{attributeNameCreate, attributeNameDelete},
+		},
+		"all": {
+			opts:     Opts{Create: true, Read: true, Update: true, Delete: true},
+			expected: []string{attributeNameCreate, attributeNameRead, attributeNameUpdate, attributeNameDelete},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			block, ok := Block(context.Background(), testCase.opts).(schema.SingleNestedBlock)
+			if !ok {
+				t.Fatalf("expected a single nested block, got: %T", block)
+			}
+
+			if len(block.Attributes) != len(testCase.expected) {
+				t.Errorf("expected attributes %v, got: %v", testCase.expected, block.Attributes)
+			}
+			for _, attributeName := range testCase.expected {
+				if _, ok := block.Attributes[attributeName]; !ok {
+					t.Errorf("expected attribute %q, got: %v", attributeName, block.Attributes)
+				}
+			}
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	configured := timeoutsValue(map[string]string{
+		attributeNameCreate: "10m",
+		attributeNameRead:   "30s",
+		attributeNameUpdate: "1h",
+		attributeNameDelete: "2h45m",
+	})
+	def := 20 * time.Minute
+
+	testCases := map[string]struct {
+		timeouts    types.Object
+		get         func(context.Context, types.Object, time.Duration) (time.Duration, diag.Diagnostics)
+		expected    time.Duration
+		expectError bool
+	}{
+		"create": {
+			timeouts: configured,
+			get:      Create,
+			expected: 10 * time.Minute,
+		},
+		"read": {
+			timeouts: configured,
+			get:      Read,
+			expected: 30 * time.Second,
+		},
+		"update": {
+			timeouts: configured,
+			get:      Update,
+			expected: time.Hour,
+		},
+		"delete": {
+			timeouts: configured,
+			get:      Delete,
+			expected: 2*time.Hour + 45*time.Minute,
+		},
+		"null-block": {
+			timeouts: types.ObjectNull(attributeTypes),
+			get:      Create,
+			expected: def,
+		},
+		"unknown-block": {
+			timeouts: types.ObjectUnknown(attributeTypes),
+			get:      Create,
+			expected: def,
+		},
+		"null-attribute": {
+			timeouts: timeoutsValue(map[string]string{attributeNameCreate: "10m"}),
+			get:      Delete,
+			expected: def,
+		},
+		"unknown-attribute": {
+			timeouts: types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+				attributeNameCreate: types.StringUnknown(),
+				attributeNameRead:   types.StringNull(),
+				attributeNameUpdate: types.StringNull(),
+				attributeNameDelete: types.StringNull(),
+			}),
+			get:      Create,
+			expected: def,
+		},
+		"attribute-not-in-block": {
+			timeouts: types.ObjectValueMust(map[string]attr.Type{
+				attributeNameCreate: types.StringType,
+			}, map[string]attr.Value{
+				attributeNameCreate: types.StringValue("10m"),
+			}),
+			get:      Read,
+			expected: def,
+		},
+		"invalid": {
+			timeouts:    timeoutsValue(map[string]string{attributeNameUpdate: "ten minutes"}),
+			get:         Update,
+			expected:    def,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.get(context.Background(), testCase.timeouts, def)
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"seconds": {
+			value: types.StringValue("30s"),
+		},
+		"hours-minutes": {
+			value: types.StringValue("2h45m"),
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"empty": {
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+		"no-unit": {
+			value:       types.StringValue("30"),
+			expectError: true,
+		},
+		"unknown-unit": {
+			value:       types.StringValue("2d"),
+			expectError: true,
+		},
+		"zero": {
+			value:       types.StringValue("0s"),
+			expectError: true,
+		},
+		"negative": {
+			value:       types.StringValue("-5m"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("timeouts").AtName(attributeNameCreate),
+				ConfigValue: testCase.value,
+			}
+			var resp validator.StringResponse
+			durationValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Package decimal provides a custom string type for exact decimal numbers,
+// such as prices. Unlike float64 attributes, it keeps the decimal digits as
+// written, so values like 0.1 don't drift through binary rounding, and
+// values that only differ in formatting, such as 4.5 and 4.50, are
+// semantically equal.
+package decimal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = Type{}
+
+// Type is the attribute type of Value. Use it as the CustomType of a string
+// attribute.
+type Type struct {
+	basetypes.StringType
+}
+
+func (t Type) String() string {
+	return "decimal.Type"
+}
+
+func (t Type) ValueType(_ context.Context) attr.Value {
+	return Value{}
+}
+
+func (t Type) Equal(o attr.Type) bool {
+	other, ok := o.(Type)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Value{StringValue: in}, nil
+}
+
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,150 @@
+package decimal
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = Value{}
+	_ xattr.ValidateableAttribute                = Value{}
+)
+
+// decimalPattern matches a number in plain decimal notation, without an
+// exponent.
+var decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// Value is an exact decimal number held as its string representation.
+type Value struct {
+	basetypes.StringValue
+}
+
+// NewValue returns a known Value holding the decimal number value.
+func NewValue(value string) Value {
+	return Value{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewFloat64Value returns a known Value holding the shortest decimal
+// representation of value, for numbers that the API only hands out as
+// float64.
+func NewFloat64Value(value float64) Value {
+	return NewValue(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// NewNull returns a null Value.
+func NewNull() Value {
+	return Value{StringValue: basetypes.NewStringNull()}
+}
+
+func (v Value) Type(_ context.Context) attr.Type {
+	return Type{}
+}
+
+func (v Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute ensures that a known value is a number in plain decimal
+// notation.
+func (v Value) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := Parse(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Decimal Value",
+			fmt.Sprintf("Attribute %s value must be a decimal number such as 4.50, got: %q", req.Path, v.ValueString()),
+		)
+	}
+}
+
+// StringSemanticEquals reports whether both values hold the same number.
+// Values that are not decimal numbers are compared as is.
+func (v Value) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+v.Type(context.Background()).String()+"\n"+
+				"Got Value Type: "+newValuable.Type(context.Background()).String(),
+		)
+		return false, diags
+	}
+
+	current, err := Parse(v.ValueString())
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	given, err := Parse(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return current.Cmp(given) == 0, diags
+}
+
+// Parse returns the exact value of s, which must be a number in plain
+// decimal notation.
+func Parse(s string) (*big.Rat, error) {
+	if !decimalPattern.MatchString(s) {
+		return nil, fmt.Errorf("%q is not a decimal number", s)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a decimal number", s)
+	}
+
+	return r, nil
+}
+
+// Format returns the shortest plain decimal representation of r. Numbers
+// without a finite decimal representation, which never result from adding
+// and multiplying decimals, are rounded to 18 digits after the point.
+func Format(r *big.Rat) string {
+	denom := new(big.Int).Set(r.Denom())
+	twos := removeFactor(denom, 2)
+	fives := removeFactor(denom, 5)
+	if denom.IsInt64() && denom.Int64() == 1 {
+		return r.FloatString(max(twos, fives))
+	}
+
+	return r.FloatString(18)
+}
+
+// removeFactor divides n by factor for as long as it is divisible and
+// returns how often it did so.
+func removeFactor(n *big.Int, factor int64) int {
+	f := big.NewInt(factor)
+	quo, rem := new(big.Int), new(big.Int)
+
+	var count int
+	for {
+		quo.QuoRem(n, f, rem)
+		if rem.Sign() != 0 {
+			return count
+		}
+		n.Set(quo)
+		count++
+	}
+}
@@ -0,0 +1,159 @@
+package decimal
+
+import (
+	"context"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       string
+		expected    *big.Rat
+		expectError bool
+	}{
+		"integer": {
+			value:    "4",
+			expected: big.NewRat(4, 1),
+		},
+		"fraction": {
+			value:    "4.50",
+			expected: big.NewRat(9, 2),
+		},
+		"negative": {
+			value:    "-0.1",
+			expected: big.NewRat(-1, 10),
+		},
+		"exponent": {
+			value:       "1e3",
+			expectError: true,
+		},
+		"ratio": {
+			value:       "1/3",
+			expectError: true,
+		},
+		"trailing-point": {
+			value:       "4.",
+			expectError: true,
+		},
+		"empty": {
+			value:       "",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(testCase.value)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got: %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Cmp(testCase.expected) != 0 {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    *big.Rat
+		expected string
+	}{
+		"integer": {
+			value:    big.NewRat(12, 1),
+			expected: "12",
+		},
+		"halves": {
+			value:    big.NewRat(9, 2),
+			expected: "4.5",
+		},
+		"cents": {
+			value:    big.NewRat(1999, 100),
+			expected: "19.99",
+		},
+		"negative": {
+			value:    big.NewRat(-1, 8),
+			expected: "-0.125",
+		},
+		"repeating": {
+			value:    big.NewRat(1, 3),
+			expected: "0.333333333333333333",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Format(testCase.value)
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValueStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current  Value
+		given    Value
+		expected bool
+	}{
+		"equal": {
+			current:  NewValue("4.5"),
+			given:    NewValue("4.5"),
+			expected: true,
+		},
+		"trailing-zeros": {
+			current:  NewValue("4.50"),
+			given:    NewValue("4.5"),
+			expected: true,
+		},
+		"float64": {
+			current:  NewValue("0.1"),
+			given:    NewFloat64Value(0.1),
+			expected: true,
+		},
+		"different": {
+			current:  NewValue("4.5"),
+			given:    NewValue("4.51"),
+			expected: false,
+		},
+		"invalid": {
+			current:  NewValue("four"),
+			given:    NewValue("4"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = Normalized{}
+	_ xattr.ValidateableAttribute                = Normalized{}
+)
+
+// Normalized is a JSON document. It is semantically equal to any other
+// Normalized holding the same document, regardless of whitespace and the
+// order of object keys.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+// NewNormalizedValue returns a known Normalized holding value.
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewNormalizedNull returns a null Normalized.
+func NewNormalizedNull() Normalized {
+	return Normalized{StringValue: basetypes.NewStringNull()}
+}
+
+func (v Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute ensures that a known value is valid JSON.
+func (v Normalized) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			fmt.Sprintf("Attribute %s value must be a valid JSON document, got: %q", req.Path, v.ValueString()),
+		)
+	}
+}
+
+// StringSemanticEquals reports whether both values hold the same JSON
+// document. Values that are not valid JSON are compared as is.
+func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+v.Type(context.Background()).String()+"\n"+
+				"Got Value Type: "+newValuable.Type(context.Background()).String(),
+		)
+		return false, diags
+	}
+
+	var current, given any
+	if json.Unmarshal([]byte(v.ValueString()), &current) != nil || json.Unmarshal([]byte(newValue.ValueString()), &given) != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	return reflect.DeepEqual(current, given), diags
+}
@@ -0,0 +1,62 @@
+package jsontypes
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNormalizedStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current  Normalized
+		given    Normalized
+		expected bool
+	}{
+		"equal": {
+			current:  NewNormalizedValue(`{"seats":40}`),
+			given:    NewNormalizedValue(`{"seats":40}`),
+			expected: true,
+		},
+		"whitespace": {
+			current:  NewNormalizedValue("{\n  \"seats\": 40\n}"),
+			given:    NewNormalizedValue(`{"seats":40}`),
+			expected: true,
+		},
+		"key-order": {
+			current:  NewNormalizedValue(`{"seats":40,"wifi":true}`),
+			given:    NewNormalizedValue(`{"wifi":true,"seats":40}`),
+			expected: true,
+		},
+		"array-order": {
+			current:  NewNormalizedValue(`["espresso","latte"]`),
+			given:    NewNormalizedValue(`["latte","espresso"]`),
+			expected: false,
+		},
+		"different": {
+			current:  NewNormalizedValue(`{"seats":40}`),
+			given:    NewNormalizedValue(`{"seats":41}`),
+			expected: false,
+		},
+		"invalid": {
+			current:  NewNormalizedValue(`{"seats":`),
+			given:    NewNormalizedValue(`{"seats":40}`),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
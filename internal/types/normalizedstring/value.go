@@ -0,0 +1,73 @@
+package normalizedstring
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringValuableWithSemanticEquals = Value{}
+
+// Value is a string that is semantically equal to any other Value that only
+// differs from it in letter case or surrounding whitespace.
+type Value struct {
+	basetypes.StringValue
+}
+
+// NewValue returns a known Value holding value.
+func NewValue(value string) Value {
+	return Value{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewNull returns a null Value.
+func NewNull() Value {
+	return Value{StringValue: basetypes.NewStringNull()}
+}
+
+func (v Value) Type(_ context.Context) attr.Type {
+	return Type{}
+}
+
+func (v Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values are equal, see Equal.
+func (v Value) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+v.Type(context.Background()).String()+"\n"+
+				"Got Value Type: "+newValuable.Type(context.Background()).String(),
+		)
+		return false, diags
+	}
+
+	return Equal(v.ValueString(), newValue.ValueString()), diags
+}
+
+// Equal reports whether a and b are equal after trimming surrounding
+// whitespace and ignoring letter case, that is, whether they have the same
+// Normalize result.
+func Equal(a, b string) bool {
+	return Normalize(a) == Normalize(b)
+}
+
+// Normalize returns the canonical form of s: lowercase, without surrounding
+// whitespace.
+func Normalize(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
@@ -0,0 +1,93 @@
+package normalizedstring
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValueStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current  Value
+		given    Value
+		expected bool
+	}{
+		"equal": {
+			current:  NewValue("Sample Cafe"),
+			given:    NewValue("Sample Cafe"),
+			expected: true,
+		},
+		"case": {
+			current:  NewValue("Sample Cafe"),
+			given:    NewValue("sample cafe"),
+			expected: true,
+		},
+		"surrounding-whitespace": {
+			current:  NewValue("Sample Cafe  "),
+			given:    NewValue("Sample Cafe"),
+			expected: true,
+		},
+		"inner-whitespace": {
+			current:  NewValue("Sample  Cafe"),
+			given:    NewValue("Sample Cafe"),
+			expected: false,
+		},
+		"different": {
+			current:  NewValue("Sample Cafe"),
+			given:    NewValue("Other Cafe"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    string
+		expected string
+	}{
+		"normalized": {
+			value:    "1 main st",
+			expected: "1 main st",
+		},
+		"case": {
+			value:    "1 Main St",
+			expected: "1 main st",
+		},
+		"surrounding-whitespace": {
+			value:    "\t1 Main St \n",
+			expected: "1 main st",
+		},
+		"inner-whitespace": {
+			value:    "1  Main St",
+			expected: "1  main st",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Normalize(testCase.value); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
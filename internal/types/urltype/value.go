@@ -0,0 +1,113 @@
+package urltype
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = Value{}
+	_ xattr.ValidateableAttribute                = Value{}
+)
+
+// Value is an absolute http or https URL. It is semantically equal to any
+// other Value with the same normalized form, see Normalize.
+type Value struct {
+	basetypes.StringValue
+}
+
+// NewValue returns a known Value holding value.
+func NewValue(value string) Value {
+	return Value{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewNull returns a null Value.
+func NewNull() Value {
+	return Value{StringValue: basetypes.NewStringNull()}
+}
+
+func (v Value) Type(_ context.Context) attr.Type {
+	return Type{}
+}
+
+func (v Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute ensures that a known value is an absolute http or https
+// URL with a host.
+func (v Value) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := Normalize(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Attribute %s value must be an absolute http or https URL, got: %q", req.Path, v.ValueString()),
+		)
+	}
+}
+
+// StringSemanticEquals reports whether both values have the same normalized
+// form. Values that cannot be normalized are compared as is.
+func (v Value) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+v.Type(context.Background()).String()+"\n"+
+				"Got Value Type: "+newValuable.Type(context.Background()).String(),
+		)
+		return false, diags
+	}
+
+	current, err := Normalize(v.ValueString())
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	given, err := Normalize(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return current == given, diags
+}
+
+// Normalize returns the normalized form of the absolute http or https URL
+// rawURL, which has a lowercase scheme and host and no trailing slash.
+func Normalize(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("%q is not an absolute http or https URL", rawURL)
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = strings.TrimRight(u.RawPath, "/")
+
+	return u.String(), nil
+}
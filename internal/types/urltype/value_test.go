@@ -0,0 +1,121 @@
+package urltype
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rawURL      string
+		expected    string
+		expectError bool
+	}{
+		"normalized": {
+			rawURL:   "https://example.com/images/cafe.png",
+			expected: "https://example.com/images/cafe.png",
+		},
+		"uppercase-scheme-and-host": {
+			rawURL:   "HTTPS://Example.COM/images/Cafe.png",
+			expected: "https://example.com/images/Cafe.png",
+		},
+		"trailing-slash": {
+			rawURL:   "https://example.com/images/",
+			expected: "https://example.com/images",
+		},
+		"root": {
+			rawURL:   "https://example.com/",
+			expected: "https://example.com",
+		},
+		"query": {
+			rawURL:   "https://example.com/cafe.png?size=large",
+			expected: "https://example.com/cafe.png?size=large",
+		},
+		"relative": {
+			rawURL:      "/images/cafe.png",
+			expectError: true,
+		},
+		"unsupported-scheme": {
+			rawURL:      "ftp://example.com/cafe.png",
+			expectError: true,
+		},
+		"empty": {
+			rawURL:      "",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Normalize(testCase.rawURL)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got: %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValueStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current  Value
+		given    Value
+		expected bool
+	}{
+		"equal": {
+			current:  NewValue("https://example.com/cafe.png"),
+			given:    NewValue("https://example.com/cafe.png"),
+			expected: true,
+		},
+		"equivalent": {
+			current:  NewValue("HTTPS://Example.com/images/"),
+			given:    NewValue("https://example.com/images"),
+			expected: true,
+		},
+		"different-path-case": {
+			current:  NewValue("https://example.com/Cafe.png"),
+			given:    NewValue("https://example.com/cafe.png"),
+			expected: false,
+		},
+		"invalid-equal": {
+			current:  NewValue(""),
+			given:    NewValue(""),
+			expected: true,
+		},
+		"invalid-different": {
+			current:  NewValue(""),
+			given:    NewValue("https://example.com"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.current.StringSemanticEquals(context.Background(), testCase.given)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
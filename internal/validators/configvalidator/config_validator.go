@@ -0,0 +1,95 @@
+package configvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ConfigValidator validates the configuration of resources as well as data
+// sources.
+type ConfigValidator interface {
+	resource.ConfigValidator
+	datasource.ConfigValidator
+}
+
+// configValidator implements ConfigValidator for a check of which of a set of
+// attributes are configured.
+type configValidator struct {
+	expressions path.Expressions
+
+	// description completes "These attributes ..." to describe the check.
+	description string
+
+	// check returns the diagnostics for the configured paths, out of those
+	// matching expressions.
+	check func(expressions path.Expressions, configured path.Paths) diag.Diagnostics
+}
+
+func (v configValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("These attributes %s: %s", v.description, formatExpressions(v.expressions))
+}
+
+func (v configValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v configValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v configValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// validate runs the check on the paths matching the validator's expressions
+// that are configured. Nothing is checked while any of them is unknown, as
+// it's not known yet whether it will be configured.
+func (v configValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var configured path.Paths
+
+	for _, expression := range v.expressions {
+		matches, matchDiags := config.PathMatches(ctx, expression)
+		diags.Append(matchDiags...)
+
+		for _, match := range matches {
+			var value attr.Value
+			diags.Append(config.GetAttribute(ctx, match, &value)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if value.IsUnknown() {
+				return diags
+			}
+			if !value.IsNull() {
+				configured.Append(match)
+			}
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(v.check(v.expressions, configured)...)
+
+	return diags
+}
+
+// formatExpressions returns the expressions as a comma-separated list.
+func formatExpressions(expressions path.Expressions) string {
+	formatted := make([]string, 0, len(expressions))
+	for _, expression := range expressions {
+		formatted = append(formatted, expression.String())
+	}
+
+	return "[" + strings.Join(formatted, ", ") + "]"
+}
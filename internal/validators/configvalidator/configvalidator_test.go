@@ -0,0 +1,100 @@
+package configvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidators(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Optional: true},
+			"name": schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+	}}
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	null := tftypes.NewValue(tftypes.String, nil)
+	set := tftypes.NewValue(tftypes.String, "1")
+
+	id, name := path.MatchRoot("id"), path.MatchRoot("name")
+
+	testCases := map[string]struct {
+		validator   ConfigValidator
+		id          tftypes.Value
+		name        tftypes.Value
+		expectError bool
+	}{
+		"exactly-one-of-one": {
+			validator: ExactlyOneOf(id, name),
+			id:        set,
+			name:      null,
+		},
+		"exactly-one-of-none": {
+			validator:   ExactlyOneOf(id, name),
+			id:          null,
+			name:        null,
+			expectError: true,
+		},
+		"exactly-one-of-both": {
+			validator:   ExactlyOneOf(id, name),
+			id:          set,
+			name:        set,
+			expectError: true,
+		},
+		"exactly-one-of-unknown": {
+			validator: ExactlyOneOf(id, name),
+			id:        unknown,
+			name:      set,
+		},
+		"conflicting-none": {
+			validator: Conflicting(id, name),
+			id:        null,
+			name:      null,
+		},
+		"conflicting-both": {
+			validator:   Conflicting(id, name),
+			id:          set,
+			name:        set,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":   testCase.id,
+					"name": testCase.name,
+				}),
+			}
+
+			var resourceResp resource.ValidateConfigResponse
+			testCase.validator.ValidateResource(context.Background(), resource.ValidateConfigRequest{Config: config}, &resourceResp)
+			if got := resourceResp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("resource: expected error %t, got diagnostics: %v", testCase.expectError, resourceResp.Diagnostics)
+			}
+
+			var dataSourceResp datasource.ValidateConfigResponse
+			testCase.validator.ValidateDataSource(context.Background(), datasource.ValidateConfigRequest{Config: config}, &dataSourceResp)
+			if got := dataSourceResp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("data source: expected error %t, got diagnostics: %v", testCase.expectError, dataSourceResp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package configvalidator
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Conflicting returns a validator which ensures that at most one of the
+// attributes matching the given expressions is configured.
+func Conflicting(expressions ...path.Expression) ConfigValidator {
+	return configValidator{
+		expressions: expressions,
+		description: "cannot be configured together",
+		check: func(expressions path.Expressions, configured path.Paths) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			if len(configured) > 1 {
+				diags.AddAttributeError(
+					configured[1],
+					"Invalid Attribute Combination",
+					fmt.Sprintf("These attributes cannot be configured together: %s", formatExpressions(expressions)),
+				)
+			}
+
+			return diags
+		},
+	}
+}
@@ -0,0 +1,6 @@
+// Package configvalidator provides validators for combinations of attributes
+// in resource and data source configurations. It mirrors the validators of
+// the same name in the resourcevalidator and datasourcevalidator packages of
+// terraform-plugin-framework-validators, which is not a dependency of this
+// provider.
+package configvalidator
@@ -0,0 +1,36 @@
+package configvalidator
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ExactlyOneOf returns a validator which ensures that exactly one of the
+// attributes matching the given expressions is configured.
+func ExactlyOneOf(expressions ...path.Expression) ConfigValidator {
+	return configValidator{
+		expressions: expressions,
+		description: "are mutually exclusive and exactly one of them must be configured",
+		check: func(expressions path.Expressions, configured path.Paths) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			switch {
+			case len(configured) == 0:
+				diags.AddError(
+					"Missing Attribute Configuration",
+					fmt.Sprintf("Exactly one of these attributes must be configured: %s", formatExpressions(expressions)),
+				)
+			case len(configured) > 1:
+				diags.AddAttributeError(
+					configured[1],
+					"Invalid Attribute Combination",
+					fmt.Sprintf("Exactly one of these attributes must be configured, got %d: %s", len(configured), formatExpressions(expressions)),
+				)
+			}
+
+			return diags
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float64 = betweenValidator{}
+
+// betweenValidator checks that a number lies within an inclusive range.
+type betweenValidator struct {
+	minValue float64
+	maxValue float64
+}
+
+// Between returns a validator which ensures that a number is at least
+// minValue and at most maxValue.
+func Between(minValue, maxValue float64) validator.Float64 {
+	return betweenValidator{minValue: minValue, maxValue: maxValue}
+}
+
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %g and %g", v.minValue, v.maxValue)
+}
+
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v betweenValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+	if value < v.minValue || value > v.maxValue {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %g", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,58 @@
+package float64validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.Float64
+		expectError bool
+	}{
+		"within": {
+			value: types.Float64Value(37.5665),
+		},
+		"min": {
+			value: types.Float64Value(-90),
+		},
+		"max": {
+			value: types.Float64Value(90),
+		},
+		"below": {
+			value:       types.Float64Value(-90.1),
+			expectError: true,
+		},
+		"above": {
+			value:       types.Float64Value(126.978),
+			expectError: true,
+		},
+		"null": {
+			value: types.Float64Null(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.Float64Response{}
+
+			Between(-90, 90).ValidateFloat64(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
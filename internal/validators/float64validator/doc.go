@@ -0,0 +1,5 @@
+// Package float64validator provides schema validators for float64
+// attributes. It mirrors the validators of the same name in
+// terraform-plugin-framework-validators, which is not a dependency of this
+// provider.
+package float64validator
@@ -0,0 +1,44 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = atLeastValidator{}
+
+// atLeastValidator checks that a number is not below a minimum.
+type atLeastValidator struct {
+	minValue int64
+}
+
+// AtLeast returns a validator which ensures that a number is at least
+// minValue.
+func AtLeast(minValue int64) validator.Int64 {
+	return atLeastValidator{minValue: minValue}
+}
+
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.minValue)
+}
+
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v atLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.minValue {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,54 @@
+package int64validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAtLeast(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.Int64
+		expectError bool
+	}{
+		"min": {
+			value: types.Int64Value(0),
+		},
+		"above": {
+			value: types.Int64Value(12),
+		},
+		"below": {
+			value:       types.Int64Value(-1),
+			expectError: true,
+		},
+		"null": {
+			value: types.Int64Null(),
+		},
+		"unknown": {
+			value: types.Int64Unknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.Int64Response{}
+
+			AtLeast(0).ValidateInt64(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = betweenValidator{}
+
+// betweenValidator checks that a number lies within an inclusive range.
+type betweenValidator struct {
+	minValue int64
+	maxValue int64
+}
+
+// Between returns a validator which ensures that a number is at least
+// minValue and at most maxValue.
+func Between(minValue, maxValue int64) validator.Int64 {
+	return betweenValidator{minValue: minValue, maxValue: maxValue}
+}
+
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.minValue, v.maxValue)
+}
+
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v betweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.minValue || value > v.maxValue {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,5 @@
+// Package int64validator provides schema validators for int64
+// attributes. It mirrors the validators of the same name in
+// terraform-plugin-framework-validators, which is not a dependency of this
+// provider.
+package int64validator
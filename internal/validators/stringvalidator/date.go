@@ -0,0 +1,43 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = dateValidator{}
+
+// dateValidator checks that a string is a calendar date in YYYY-MM-DD
+// format.
+type dateValidator struct{}
+
+// Date returns a validator which ensures that a string is a calendar date in
+// YYYY-MM-DD format, such as "2024-12-25".
+func Date() validator.String {
+	return dateValidator{}
+}
+
+func (v dateValidator) Description(_ context.Context) string {
+	return "value must be a date in YYYY-MM-DD format, such as \"2024-12-25\""
+}
+
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.DateOnly, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,6 @@
+// Package stringvalidator provides schema validators for string attributes.
+// It mirrors the validators of the same name in
+// terraform-plugin-framework-validators, which is not a dependency of this
+// provider, so that attributes are checked at plan time instead of failing
+// at apply time with raw API errors.
+package stringvalidator
@@ -0,0 +1,66 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = lengthValidator{}
+
+// lengthValidator checks that a string is between minLength and maxLength
+// characters long. A negative maxLength means no upper bound.
+type lengthValidator struct {
+	minLength int
+	maxLength int
+}
+
+// LengthBetween returns a validator which ensures that a string is at least
+// minLength and at most maxLength characters long.
+func LengthBetween(minLength, maxLength int) validator.String {
+	return lengthValidator{minLength: minLength, maxLength: maxLength}
+}
+
+// LengthAtLeast returns a validator which ensures that a string is at least
+// minLength characters long.
+func LengthAtLeast(minLength int) validator.String {
+	return lengthValidator{minLength: minLength, maxLength: -1}
+}
+
+// LengthAtMost returns a validator which ensures that a string is at most
+// maxLength characters long.
+func LengthAtMost(maxLength int) validator.String {
+	return lengthValidator{maxLength: maxLength}
+}
+
+func (v lengthValidator) Description(_ context.Context) string {
+	switch {
+	case v.maxLength < 0:
+		return fmt.Sprintf("string length must be at least %d", v.minLength)
+	case v.minLength <= 0:
+		return fmt.Sprintf("string length must be at most %d", v.maxLength)
+	default:
+		return fmt.Sprintf("string length must be between %d and %d", v.minLength, v.maxLength)
+	}
+}
+
+func (v lengthValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v lengthValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	length := utf8.RuneCountInString(req.ConfigValue.ValueString())
+	if length < v.minLength || (v.maxLength >= 0 && length > v.maxLength) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Length",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), length),
+		)
+	}
+}
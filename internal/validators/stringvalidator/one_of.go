@@ -0,0 +1,50 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = oneOfValidator{}
+
+// oneOfValidator checks that a string is one of a fixed set of values.
+type oneOfValidator struct {
+	values []string
+}
+
+// OneOf returns a validator which ensures that a string is equal to one of
+// the given values.
+func OneOf(values ...string) validator.String {
+	return oneOfValidator{values: values}
+}
+
+func (v oneOfValidator) Description(_ context.Context) string {
+	quoted := make([]string, 0, len(v.values))
+	for _, value := range v.values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return "value must be one of: " + strings.Join(quoted, ", ")
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(v.values, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Match",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
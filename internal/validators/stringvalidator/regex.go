@@ -0,0 +1,43 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = regexValidator{}
+
+// regexValidator checks that a string is a valid regular expression.
+type regexValidator struct{}
+
+// Regex returns a validator which ensures that a string is a regular
+// expression in the RE2 syntax accepted by the regexp package, such as
+// "^Cafe .*".
+func Regex() validator.String {
+	return regexValidator{}
+}
+
+func (v regexValidator) Description(_ context.Context) string {
+	return "value must be a valid RE2 regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Attribute %s %s, got: %q: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
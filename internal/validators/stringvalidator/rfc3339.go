@@ -0,0 +1,42 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator checks that a string is an RFC 3339 timestamp.
+type rfc3339Validator struct{}
+
+// RFC3339 returns a validator which ensures that a string is an RFC 3339
+// timestamp, such as "2024-05-01T09:00:00Z".
+func RFC3339() validator.String {
+	return rfc3339Validator{}
+}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp, such as \"2024-05-01T09:00:00Z\""
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
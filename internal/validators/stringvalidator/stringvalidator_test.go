@@ -0,0 +1,134 @@
+package stringvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator   validator.String
+		value       types.String
+		expectError bool
+	}{
+		"length-between-valid": {
+			validator: LengthBetween(1, 5),
+			value:     types.StringValue("cafe"),
+		},
+		"length-between-empty": {
+			validator:   LengthBetween(1, 5),
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+		"length-between-too-long": {
+			validator:   LengthBetween(1, 5),
+			value:       types.StringValue("coffee"),
+			expectError: true,
+		},
+		"length-between-counts-characters": {
+			validator: LengthBetween(1, 2),
+			value:     types.StringValue("카페"),
+		},
+		"length-at-least-valid": {
+			validator: LengthAtLeast(1),
+			value:     types.StringValue("1 Main St"),
+		},
+		"length-at-least-invalid": {
+			validator:   LengthAtLeast(1),
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+		"length-at-most-invalid": {
+			validator:   LengthAtMost(3),
+			value:       types.StringValue("espresso"),
+			expectError: true,
+		},
+		"length-null": {
+			validator: LengthAtLeast(1),
+			value:     types.StringNull(),
+		},
+		"length-unknown": {
+			validator: LengthAtLeast(1),
+			value:     types.StringUnknown(),
+		},
+		"one-of-valid": {
+			validator: OneOf("open", "closed"),
+			value:     types.StringValue("open"),
+		},
+		"one-of-invalid": {
+			validator:   OneOf("open", "closed"),
+			value:       types.StringValue("Open"),
+			expectError: true,
+		},
+		"time-of-day-valid": {
+			validator: TimeOfDay(),
+			value:     types.StringValue("07:30"),
+		},
+		"time-of-day-single-digit-hour": {
+			validator:   TimeOfDay(),
+			value:       types.StringValue("7:30"),
+			expectError: true,
+		},
+		"time-of-day-out-of-range": {
+			validator:   TimeOfDay(),
+			value:       types.StringValue("24:00"),
+			expectError: true,
+		},
+		"rfc3339-valid": {
+			validator: RFC3339(),
+			value:     types.StringValue("2024-05-01T09:00:00+09:00"),
+		},
+		"rfc3339-date-only": {
+			validator:   RFC3339(),
+			value:       types.StringValue("2024-05-01"),
+			expectError: true,
+		},
+		"date-valid": {
+			validator: Date(),
+			value:     types.StringValue("2024-12-25"),
+		},
+		"date-timestamp": {
+			validator:   Date(),
+			value:       types.StringValue("2024-12-25T09:00:00Z"),
+			expectError: true,
+		},
+		"date-out-of-range": {
+			validator:   Date(),
+			value:       types.StringValue("2024-02-30"),
+			expectError: true,
+		},
+		"regex-valid": {
+			validator: Regex(),
+			value:     types.StringValue("^Cafe (North|South)$"),
+		},
+		"regex-invalid": {
+			validator:   Regex(),
+			value:       types.StringValue("Cafe ("),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			testCase.validator.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != testCase.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
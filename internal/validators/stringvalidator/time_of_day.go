@@ -0,0 +1,45 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = timeOfDayValidator{}
+
+// timeOfDayRegexp matches a 24-hour clock time such as "07:30" or "23:59".
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// timeOfDayValidator checks that a string is a time of day in HH:MM format.
+type timeOfDayValidator struct{}
+
+// TimeOfDay returns a validator which ensures that a string is a 24-hour
+// clock time in HH:MM format.
+func TimeOfDay() validator.String {
+	return timeOfDayValidator{}
+}
+
+func (v timeOfDayValidator) Description(_ context.Context) string {
+	return "value must be a 24-hour time in HH:MM format, such as \"07:30\""
+}
+
+func (v timeOfDayValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeOfDayValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !timeOfDayRegexp.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time of Day",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,303 @@
+// Package vcr records the HTTP traffic of acceptance tests to cassette files
+// and replays it, so that tests run deterministically and offline in CI.
+//
+// A cassette is a JSON file listing the requests a test made and the
+// responses it got, in order. Requests are identified by method, path,
+// query and body, never by host, so a cassette recorded against one backend
+// replays whatever host the provider is configured with. Credentials such as
+// passwords and tokens are redacted from the bodies before they are written.
+//
+// Tests replay their cassette by default. Setting TF_ACC_RECORD re-records it
+// against the live backend the provider is configured with.
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// RecordEnvVar is the environment variable that switches tests from
+// replaying their cassettes to re-recording them.
+const RecordEnvVar = "TF_ACC_RECORD"
+
+// redacted replaces the values of sensitiveFields in recorded bodies.
+const redacted = "REDACTED"
+
+// sensitiveFields are the JSON fields whose values are redacted from
+// recorded request and response bodies.
+var sensitiveFields = []string{
+	"access_token",
+	"admin_password",
+	"client_secret",
+	"password",
+	"refresh_token",
+	"token",
+}
+
+// Mode is whether a Recorder records or replays.
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette without sending them.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the backend and writes the cassette.
+	ModeRecord
+)
+
+// ModeFromEnv returns ModeRecord when RecordEnvVar is set, and ModeReplay
+// otherwise.
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnvVar) != "" {
+		return ModeRecord
+	}
+
+	return ModeReplay
+}
+
+// Interaction is a recorded request and the response to it.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. URL holds the path and query only.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder records or replays the interactions of one cassette. It is safe
+// for concurrent use.
+type Recorder struct {
+	path string
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// New returns a Recorder for the cassette at path. In ModeReplay the
+// cassette must exist. In ModeRecord it is replaced when Stop is called.
+func New(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode}
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("vcr: reading cassette: %w", err)
+	}
+	if err := json.Unmarshal(b, &r.interactions); err != nil {
+		return nil, fmt.Errorf("vcr: parsing cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.interactions))
+
+	return r, nil
+}
+
+// Wrap returns a transport that records the requests it sends through base,
+// or that replays them without using base.
+func (r *Recorder) Wrap(base http.RoundTripper) http.RoundTripper {
+	return &transport{recorder: r, base: base}
+}
+
+// Stop writes the cassette in ModeRecord. In ModeReplay it returns an error
+// if some interactions were never replayed, as the test no longer makes
+// the requests it was recorded with and its cassette should be re-recorded.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeReplay {
+		if unused := len(r.used) - countTrue(r.used); unused > 0 {
+			return fmt.Errorf("vcr: %d of %d interactions in %s were not replayed; re-record it with %s=1", unused, len(r.used), r.path, RecordEnvVar)
+		}
+
+		return nil
+	}
+
+	b, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(b, '\n'), 0o644)
+}
+
+// transport is the http.RoundTripper returned by Recorder.Wrap.
+type transport struct {
+	recorder *Recorder
+	base     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := Request{
+		Method: req.Method,
+		URL:    req.URL.RequestURI(),
+		Body:   redact(body),
+	}
+
+	if t.recorder.mode == ModeReplay {
+		return t.recorder.replay(req, recorded)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Content-Length is left out as redacting the body may change its length.
+	// Replayed responses get the length of the recorded body instead.
+	header := resp.Header.Clone()
+	header.Del("Content-Length")
+	header.Del("Date")
+	header.Del("Set-Cookie")
+
+	t.recorder.mu.Lock()
+	defer t.recorder.mu.Unlock()
+
+	t.recorder.interactions = append(t.recorder.interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       redact(respBody),
+		},
+	})
+
+	return resp, nil
+}
+
+// replay answers req with the first interaction for recorded that has not
+// been replayed yet.
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request != recorded {
+			continue
+		}
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("vcr: no interaction in %s for %s %s; re-record it with %s=1", r.path, recorded.Method, recorded.URL, RecordEnvVar)
+}
+
+// readBody reads the body at body, if any, and replaces it with a reader over
+// the same bytes so that it can still be sent or read.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	b, err := io.ReadAll(*body)
+	closeErr := (*body).Close()
+	if err := errors.Join(err, closeErr); err != nil {
+		return "", err
+	}
+	*body = io.NopCloser(bytes.NewReader(b))
+
+	return string(b), nil
+}
+
+// redact returns body with the values of sensitiveFields replaced, when body
+// is JSON. Other bodies are returned unchanged.
+func redact(body string) string {
+	var v any
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+
+	if !redactValue(v) {
+		return body
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+
+	return string(b)
+}
+
+// redactValue replaces the values of sensitiveFields in v, reporting whether
+// it replaced any.
+func redactValue(v any) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if slices.Contains(sensitiveFields, key) {
+				v[key] = redacted
+				changed = true
+				continue
+			}
+			changed = redactValue(value) || changed
+		}
+	case []any:
+		for _, value := range v {
+			changed = redactValue(value) || changed
+		}
+	}
+
+	return changed
+}
+
+// countTrue returns the number of true values in values.
+func countTrue(values []bool) int {
+	n := 0
+	for _, value := range values {
+		if value {
+			n++
+		}
+	}
+
+	return n
+}
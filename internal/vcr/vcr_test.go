@@ -0,0 +1,214 @@
+package vcr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// send sends a request through rt and returns the response status and body.
+func send(t *testing.T, rt http.RoundTripper, method, url, body string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return resp.StatusCode, string(b)
+}
+
+func TestRecordReplay(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		b, _ := io.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/signin":
+			w.Write([]byte(`{"username":"education","token":"secret-token"}`))
+		case "/cafes":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(append([]byte("created "), b...))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "cassettes", "TestRecordReplay.json")
+
+	recorder, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	rt := recorder.Wrap(http.DefaultTransport)
+
+	if _, body := send(t, rt, http.MethodPost, server.URL+"/signin", `{"username":"education","password":"hunter2"}`); !strings.Contains(body, "secret-token") {
+		t.Errorf("expected the live token while recording, got: %s", body)
+	}
+	send(t, rt, http.MethodPost, server.URL+"/cafes?dry_run=false", `[{"name":"Cafe North"}]`)
+	send(t, rt, http.MethodPost, server.URL+"/cafes?dry_run=false", `[{"name":"Cafe South"}]`)
+
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cassette, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, secret := range []string{"hunter2", "secret-token"} {
+		if strings.Contains(string(cassette), secret) {
+			t.Errorf("expected %q to be redacted from the cassette, got: %s", secret, cassette)
+		}
+	}
+
+	recorded := requests.Load()
+
+	replayer, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Replaying uses neither the base transport nor the recorded host.
+	rt = replayer.Wrap(nil)
+
+	send(t, rt, http.MethodPost, "http://replay.invalid/signin", `{"username":"education","password":"hunter2"}`)
+	status, body := send(t, rt, http.MethodPost, "http://replay.invalid/cafes?dry_run=false", `[{"name":"Cafe South"}]`)
+	if status != http.StatusCreated || body != `created [{"name":"Cafe South"}]` {
+		t.Errorf("expected the recorded response, got %d: %s", status, body)
+	}
+
+	if err := replayer.Stop(); err == nil {
+		t.Errorf("expected an error for the interaction that was not replayed")
+	}
+
+	send(t, rt, http.MethodPost, "http://replay.invalid/cafes?dry_run=false", `[{"name":"Cafe North"}]`)
+
+	if err := replayer.Stop(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if got := requests.Load(); got != recorded {
+		t.Errorf("expected no requests to the server while replaying, got %d", got-recorded)
+	}
+}
+
+func TestReplayUnmatched(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := os.WriteFile(path, []byte(`[{"request": {"method": "GET", "url": "/cafes"}, "response": {"status_code": 200, "body": "[]"}}]`), 0o644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		method      string
+		url         string
+		expectError bool
+	}{
+		"matching": {
+			method: http.MethodGet,
+			url:    "http://localhost/cafes",
+		},
+		"other-method": {
+			method:      http.MethodDelete,
+			url:         "http://localhost/cafes",
+			expectError: true,
+		},
+		"other-query": {
+			method:      http.MethodGet,
+			url:         "http://localhost/cafes?page=2",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			replayer, err := New(path, ModeReplay)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			req, err := http.NewRequest(testCase.method, testCase.url, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp, err := replayer.Wrap(nil).RoundTrip(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+
+			if got := err != nil; got != testCase.expectError {
+				t.Errorf("expected error %t, got: %v", testCase.expectError, err)
+			}
+		})
+	}
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay); err == nil {
+		t.Errorf("expected an error for a missing cassette")
+	}
+}
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body     string
+		expected string
+	}{
+		"empty": {
+			body:     "",
+			expected: "",
+		},
+		"not-json": {
+			body:     "Deleted order",
+			expected: "Deleted order",
+		},
+		"nothing-sensitive": {
+			body:     `{"name": "Cafe North"}`,
+			expected: `{"name": "Cafe North"}`,
+		},
+		"top-level": {
+			body:     `{"password":"hunter2","username":"education"}`,
+			expected: `{"password":"REDACTED","username":"education"}`,
+		},
+		"nested": {
+			body:     `[{"admin_password":"hunter2","name":"Cafe North"}]`,
+			expected: `[{"admin_password":"REDACTED","name":"Cafe North"}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := redact(testCase.body); got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}